@@ -0,0 +1,123 @@
+package synopcsv
+
+import (
+	"math"
+
+	"github.com/jfyuen/synopcsv/codes"
+)
+
+// CloudLayer is a cloud layer as reported in nnuageN, ctypeN and hnuageN
+type CloudLayer struct {
+	Amount     *int // in octa
+	Genus      *int // code 0500
+	BaseHeight *int // in m
+}
+
+// Cover returns the aviation cover of the layer: FEW, SCT, BKN or OVC, VV (vertical visibility) for a sky obscured by fog
+// or other phenomena (amount 9 of code table 2700), or an empty string for no or unknown amount
+func (l CloudLayer) Cover() string {
+	if l.Amount == nil {
+		return ""
+	}
+	switch a := *l.Amount; {
+	case a <= 0:
+		return ""
+	case a <= 2:
+		return "FEW"
+	case a <= 4:
+		return "SCT"
+	case a <= 7:
+		return "BKN"
+	case a == 8:
+		return "OVC"
+	case a == 9:
+		return "VV"
+	default:
+		return ""
+	}
+}
+
+// GenusText describes the genus of the layer
+func (l CloudLayer) GenusText(lang codes.Language) string {
+	return codeText(l.Genus, codes.CloudGenus, lang)
+}
+
+// Clouds returns the reported cloud layers, in the reported order which is from the lowest
+func (m Measure) Clouds() []CloudLayer {
+	all := []CloudLayer{
+		{m.LevelCloudNebulosity1, m.LevelCloudType1, m.LevelBaseHeight1},
+		{m.LevelCloudNebulosity2, m.LevelCloudType2, m.LevelBaseHeight2},
+		{m.LevelCloudNebulosity3, m.LevelCloudType3, m.LevelBaseHeight3},
+		{m.LevelCloudNebulosity4, m.LevelCloudType4, m.LevelBaseHeight4},
+	}
+	layers := make([]CloudLayer, 0, len(all))
+	for _, l := range all {
+		if l.Amount != nil || l.Genus != nil || l.BaseHeight != nil {
+			layers = append(layers, l)
+		}
+	}
+	return layers
+}
+
+// Ceiling returns the base height in m of the lowest layer covering at least 5 octas (BKN or OVC) or obscuring the sky (VV).
+// Lower level clouds from nbas and hbas are used when no layer is reported.
+func (m Measure) Ceiling() (int, bool) {
+	layers := m.Clouds()
+	if len(layers) == 0 {
+		layers = []CloudLayer{{Amount: m.LowerLevelCloudNebulosity, BaseHeight: m.LowerLevelCloudHeight}}
+	}
+	ceiling, found := 0, false
+	for _, l := range layers {
+		switch l.Cover() {
+		case "BKN", "OVC", "VV":
+		default:
+			continue
+		}
+		if l.BaseHeight == nil {
+			continue
+		}
+		if !found || *l.BaseHeight < ceiling {
+			ceiling, found = *l.BaseHeight, true
+		}
+	}
+	return ceiling, found
+}
+
+// TotalCover returns TotalNebulosity in octas, 0 and 8 being kept for clear and fully overcast skies,
+// and 9 for a sky obscured by fog or other phenomena
+func (m Measure) TotalCover() (int, bool) {
+	if m.TotalNebulosity == nil {
+		return 0, false
+	}
+	n := *m.TotalNebulosity
+	switch {
+	case n <= 0:
+		return 0, true
+	case n > 100:
+		return 9, true
+	case n == 100:
+		return 8, true
+	}
+	octas := int(math.Round(n * 8 / 100))
+	if octas < 1 {
+		octas = 1
+	} else if octas > 7 {
+		octas = 7
+	}
+	return octas, true
+}
+
+// HighestLayer returns the reported layer with the highest base
+func (m Measure) HighestLayer() (CloudLayer, bool) {
+	var highest CloudLayer
+	found := false
+	for _, l := range m.Clouds() {
+		if l.BaseHeight == nil {
+			continue
+		}
+		if !found || *l.BaseHeight > *highest.BaseHeight {
+			highest, found = l, true
+		}
+	}
+	return highest, found
+}
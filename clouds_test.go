@@ -0,0 +1,75 @@
+package synopcsv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jfyuen/synopcsv/codes"
+)
+
+func TestClouds(t *testing.T) {
+	// 07015 reports a 7 octas Stratocumulus layer at 300 m and an overcast one at 900 m
+	measures, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	m := measures[1]
+	layers := m.Clouds()
+	if len(layers) != 2 || *layers[0].Amount != 7 || *layers[1].BaseHeight != 900 {
+		t.Fatalf("Invalid layers: %+v", layers)
+	}
+	if layers[0].Cover() != "BKN" || layers[1].Cover() != "OVC" || layers[0].GenusText(codes.English) != "Stratocumulus (Sc)" {
+		t.Errorf("Invalid layer description: %v %v %v", layers[0].Cover(), layers[1].Cover(), layers[0].GenusText(codes.English))
+	}
+	if ceiling, ok := m.Ceiling(); !ok || ceiling != 300 {
+		t.Errorf("Invalid ceiling: %v", ceiling)
+	}
+	if octas, ok := m.TotalCover(); !ok || octas != 8 {
+		t.Errorf("Invalid total cover: %v", octas)
+	}
+	if highest, ok := m.HighestLayer(); !ok || *highest.BaseHeight != 900 {
+		t.Errorf("Invalid highest layer: %+v", highest)
+	}
+
+	n := 90.0
+	m = Measure{TotalNebulosity: &n, LowerLevelCloudNebulosity: layers[0].Amount, LowerLevelCloudHeight: layers[1].BaseHeight}
+	if octas, _ := m.TotalCover(); octas != 7 {
+		t.Errorf("Invalid total cover: %v", octas)
+	}
+	if ceiling, ok := m.Ceiling(); !ok || ceiling != 900 {
+		t.Errorf("Invalid ceiling from lower level clouds: %v", ceiling)
+	}
+	if _, ok := m.HighestLayer(); ok {
+		t.Error("no layer should be found")
+	}
+}
+
+func TestCeilingAmounts(t *testing.T) {
+	tests := []struct {
+		amount  int
+		ceiling bool
+	}{{4, false}, {5, true}, {8, true}, {9, true}, {10, false}, {99, false}}
+	for _, test := range tests {
+		amount, height := test.amount, 600
+		m := Measure{LevelCloudNebulosity1: &amount, LevelBaseHeight1: &height}
+		if ceiling, ok := m.Ceiling(); ok != test.ceiling || (ok && ceiling != height) {
+			t.Errorf("Invalid ceiling for %v octas: %v %v", test.amount, ceiling, ok)
+		}
+	}
+}
+
+func TestCloudLayerCover(t *testing.T) {
+	tests := []struct {
+		amount int
+		cover  string
+	}{{0, ""}, {1, "FEW"}, {3, "SCT"}, {6, "BKN"}, {8, "OVC"}, {9, "VV"}, {10, ""}}
+	for _, test := range tests {
+		amount := test.amount
+		if c := (CloudLayer{Amount: &amount}).Cover(); c != test.cover {
+			t.Errorf("Invalid cover for %v octas: %q, expected %q", test.amount, c, test.cover)
+		}
+	}
+	if c := (CloudLayer{}).Cover(); c != "" {
+		t.Errorf("Invalid cover for an unknown amount: %q", c)
+	}
+}
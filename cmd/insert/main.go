@@ -2,6 +2,8 @@ package main
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -24,7 +26,17 @@ func checkError(err error) {
 	}
 }
 
-func downloadFile(path string, f func() (io.Reader, error)) error {
+// storeName returns the file name to store a downloaded csv file in
+func storeName(storePath string, name string, compress bool) string {
+	filename := path.Join(storePath, name+".csv")
+	if compress {
+		filename += ".gz"
+	}
+	return filename
+}
+
+// downloadFile stores the content returned by f in path, gzip compressed or not whatever it was downloaded as
+func downloadFile(path string, compress bool, f func() (io.Reader, error)) error {
 	if fileExists(path) {
 		return nil
 	}
@@ -33,32 +45,70 @@ func downloadFile(path string, f func() (io.Reader, error)) error {
 		return errors.WithStack(err)
 	}
 	buf := new(bytes.Buffer)
-	buf.ReadFrom(r)
-	err = ioutil.WriteFile(path, buf.Bytes(), 0644)
+	if _, err := buf.ReadFrom(r); err != nil {
+		return errors.WithStack(err)
+	}
+	data := buf.Bytes()
+	if compress && !synopcsv.IsGzip(data) {
+		buf = new(bytes.Buffer)
+		w := gzip.NewWriter(buf)
+		if _, err := w.Write(data); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := w.Close(); err != nil {
+			return errors.WithStack(err)
+		}
+		data = buf.Bytes()
+	} else if !compress && synopcsv.IsGzip(data) {
+		data, err = ioutil.ReadAll(synopcsv.Decompress(bytes.NewReader(data)))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	err = ioutil.WriteFile(path, data, 0644)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
 }
 
-func fetchSingleMeasureCSV(at string, storePath string) ([]synopcsv.Measure, error) {
+// cachedOpen returns a function opening measure files from storePath, downloading them first when missing
+func cachedOpen(fetcher *synopcsv.Fetcher, storePath string, compress bool) func(ctx context.Context, date string) (io.Reader, error) {
+	return func(ctx context.Context, date string) (io.Reader, error) {
+		filename := storeName(storePath, date, compress)
+		err := downloadFile(filename, compress, func() (io.Reader, error) { return fetcher.FetchMeasureCSV(ctx, date) })
+		if err != nil {
+			return nil, err
+		}
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return f, nil
+	}
+}
+
+func fetchSingleMeasureCSV(fetcher *synopcsv.Fetcher, at string, storePath string, compress bool) ([]synopcsv.Measure, error) {
 	_, err := time.Parse("2006010215", at)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid date")
 	}
 
-	filename := path.Join(storePath, at+".csv")
-	err = downloadFile(filename, func() (io.Reader, error) { return synopcsv.FetchMeasureCSV(at) })
-
-	f, err := os.Open(filename)
+	r, err := cachedOpen(fetcher, storePath, compress)(context.Background(), at)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, err
 	}
-	defer f.Close()
-	return synopcsv.ParseMeasureCSV(f)
+	measures, err := synopcsv.ParseMeasureCSV(r)
+	if c, ok := r.(io.Closer); ok {
+		if errClose := c.Close(); err == nil && errClose != nil {
+			err = errors.WithStack(errClose)
+		}
+	}
+	return measures, err
 }
 
-func fetchMultipleMeasureCSV(start string, end string, storePath string) ([]synopcsv.Measure, error) {
+// fetchMultipleMeasureCSV downloads measures concurrently, sending them by file in date order until ctx is canceled
+func fetchMultipleMeasureCSV(ctx context.Context, fetcher *synopcsv.Fetcher, start string, end string, f flags) (<-chan synopcsv.Batch, error) {
 	fromDate, err := time.Parse("200601", start)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid start date")
@@ -67,24 +117,13 @@ func fetchMultipleMeasureCSV(start string, end string, storePath string) ([]syno
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid end date")
 	}
-	measures := make([]synopcsv.Measure, 0)
-	for d := fromDate; d.Before(toDate); d = d.AddDate(0, 1, 0) {
-		dateStr := d.Format("200601")
-		filename := path.Join(storePath, dateStr+".csv")
-		err := downloadFile(filename, func() (io.Reader, error) { return synopcsv.FetchMeasureCSV(dateStr) })
-
-		f, err := os.Open(filename)
-		if err != nil {
-			return nil, errors.WithStack(err)
-		}
-		defer f.Close()
-		monthlyMeasures, err := synopcsv.ParseMeasureCSV(f)
-		if err != nil {
-			return nil, err
-		}
-		measures = append(measures, monthlyMeasures...)
+	d := &synopcsv.Downloader{
+		Fetcher:  fetcher,
+		Workers:  f.workers,
+		Interval: f.interval,
+		Open:     cachedOpen(fetcher, f.downloadPath, f.compress),
 	}
-	return measures, nil
+	return d.DownloadRange(ctx, fromDate, toDate), nil
 }
 
 func fileExists(path string) bool {
@@ -102,7 +141,10 @@ func readStations(p string) ([]synopcsv.Station, error) {
 }
 
 type flags struct {
-	dbURL, dbName, user, passwd, from, to, at, downloadPath, seriesName string
+	dbURL, dbName, user, passwd, from, to, at, downloadPath, seriesName, baseURL string
+	compress, comfort                                                            bool
+	retries, workers                                                             int
+	interval                                                                     time.Duration
 }
 
 func (f flags) check() {
@@ -133,11 +175,28 @@ func newFlags() flags {
 	flag.StringVar(&f.at, "at", "", "fetch meteo data at date (incompatible with -from/-to), use YYYYMMDDHH")
 	flag.StringVar(&f.downloadPath, "path", ".", "where to store downloaded files (default to current directory)")
 	flag.StringVar(&f.seriesName, "seriesName", "measurements", "series to store values in")
+	flag.BoolVar(&f.compress, "gzip", false, "keep downloaded files gzip compressed on disk")
+	flag.StringVar(&f.baseURL, "baseURL", synopcsv.DefaultBaseURL, "url of the directory to download files from")
+	flag.IntVar(&f.retries, "retries", 3, "how many times to retry a failed download")
+	flag.IntVar(&f.workers, "workers", 4, "number of parallel downloads")
+	flag.DurationVar(&f.interval, "interval", 500*time.Millisecond, "minimal time between the start of two downloads")
+	flag.BoolVar(&f.comfort, "comfort", false, "also store thermal comfort indices (heat index, humidex, wind chill, apparent temperature)")
 	flag.Parse()
 	return f
 }
 
-func createPoint(m synopcsv.Measure, stationsMap map[string]synopcsv.Station, seriesName string) (*client.Point, error) {
+// comfortFields lists the thermal comfort indices stored with -comfort
+var comfortFields = []struct {
+	name  string
+	value func(synopcsv.Measure) (float64, bool)
+}{
+	{"heat_index", synopcsv.Measure.HeatIndex},
+	{"humidex", synopcsv.Measure.Humidex},
+	{"wind_chill", synopcsv.Measure.WindChill},
+	{"apparent_temperature", synopcsv.Measure.ApparentTemperature},
+}
+
+func createPoint(m synopcsv.Measure, stationsMap map[string]synopcsv.Station, seriesName string, comfort bool) (*client.Point, error) {
 	tags := map[string]string{
 		"station_id": m.StationID,
 	}
@@ -160,6 +219,14 @@ func createPoint(m synopcsv.Measure, stationsMap map[string]synopcsv.Station, se
 		fields["wind_speed"] = *m.WindSpeed
 	}
 
+	if comfort {
+		for _, c := range comfortFields {
+			if v, ok := c.value(m); ok {
+				fields[c.name] = v
+			}
+		}
+	}
+
 	pt, err := client.NewPoint(
 		seriesName,
 		tags,
@@ -169,17 +236,16 @@ func createPoint(m synopcsv.Measure, stationsMap map[string]synopcsv.Station, se
 	return pt, errors.WithStack(err)
 }
 
-func insertMeasuresInflux(measures []synopcsv.Measure, stationsMap map[string]synopcsv.Station, f flags) error {
+func newInfluxClient(f flags) (client.Client, error) {
 	c, err := client.NewHTTPClient(client.HTTPConfig{
 		Addr:     f.dbURL,
 		Username: f.user,
 		Password: f.passwd,
 	})
-	if err != nil {
-		return errors.Wrap(err, "Error creating InfluxDB Client")
-	}
-	defer c.Close()
+	return c, errors.Wrap(err, "Error creating InfluxDB Client")
+}
 
+func insertMeasuresInflux(c client.Client, measures []synopcsv.Measure, stationsMap map[string]synopcsv.Station, f flags) error {
 	bpconf := client.BatchPointsConfig{
 		Database:  f.dbName,
 		Precision: "s",
@@ -188,7 +254,7 @@ func insertMeasuresInflux(measures []synopcsv.Measure, stationsMap map[string]sy
 
 	batchSize := 5000
 	for _, m := range measures {
-		pt, err := createPoint(m, stationsMap, f.seriesName)
+		pt, err := createPoint(m, stationsMap, f.seriesName, f.comfort)
 		if err != nil {
 			return errors.Wrap(err, "error creating point")
 		}
@@ -204,31 +270,66 @@ func insertMeasuresInflux(measures []synopcsv.Measure, stationsMap map[string]sy
 	return errors.Wrap(c.Write(bp), "error batch writing")
 }
 
-func main() {
-	f := newFlags()
-	f.check()
-
-	stationFilename := path.Join(f.downloadPath, "stations.csv")
-	err := downloadFile(stationFilename, synopcsv.FetchStationCSV)
-	checkError(err)
+// run downloads and inserts the measures selected by f
+func run(f flags) error {
+	fetcher := &synopcsv.Fetcher{BaseURL: f.baseURL, Retries: f.retries}
+	stationFilename := storeName(f.downloadPath, "stations", f.compress)
+	err := downloadFile(stationFilename, f.compress, func() (io.Reader, error) { return fetcher.FetchStationCSV(context.Background()) })
+	if err != nil {
+		return err
+	}
 
 	stations, err := readStations(stationFilename)
-	checkError(err)
+	if err != nil {
+		return err
+	}
 	stationsMap := make(map[string]synopcsv.Station)
 	for _, s := range stations {
 		stationsMap[s.ID] = s
 	}
 
-	var measures []synopcsv.Measure
-	if f.at != "" {
-		measures, err = fetchSingleMeasureCSV(f.at, f.downloadPath)
-	} else {
-		measures, err = fetchMultipleMeasureCSV(f.from, f.to, f.downloadPath)
+	var c client.Client
+	if f.dbURL != "" {
+		c, err = newInfluxClient(f)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+	}
+	insert := func(measures []synopcsv.Measure) error {
+		if c == nil {
+			return nil
+		}
+		return insertMeasuresInflux(c, measures, stationsMap, f)
 	}
-	checkError(err)
 
-	if f.dbURL != "" {
-		err := insertMeasuresInflux(measures, stationsMap, f)
-		checkError(err)
+	if f.at != "" {
+		measures, err := fetchSingleMeasureCSV(fetcher, f.at, f.downloadPath, f.compress)
+		if err != nil {
+			return err
+		}
+		return insert(measures)
+	}
+	// stops the downloads when returning before the last batch
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	batches, err := fetchMultipleMeasureCSV(ctx, fetcher, f.from, f.to, f)
+	if err != nil {
+		return err
 	}
+	for batch := range batches {
+		if batch.Err != nil {
+			return batch.Err
+		}
+		if err := insert(batch.Measures); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	f := newFlags()
+	f.check()
+	checkError(run(f))
 }
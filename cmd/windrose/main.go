@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfyuen/synopcsv"
+	"github.com/jfyuen/synopcsv/windrose"
+	"github.com/pkg/errors"
+)
+
+func checkError(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
+
+type flags struct {
+	station, from, to, bins, output, baseURL string
+	sectors, size, retries, workers          int
+	interval                                 time.Duration
+}
+
+func newFlags() flags {
+	f := flags{}
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "%s: draws the wind rose of a SYNOP station as SVG, from meteo france data\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.StringVar(&f.station, "station", "", "station ID, as 07149")
+	flag.StringVar(&f.from, "from", "", "use meteo data from date, use YYYYMM")
+	flag.StringVar(&f.to, "to", "", "use meteo data to date excluded, use YYYYMM")
+	flag.IntVar(&f.sectors, "sectors", windrose.DefaultSectors, "number of direction sectors")
+	flag.StringVar(&f.bins, "bins", "2,4,6,8,10", "comma separated speed classes bounds in m/s")
+	flag.StringVar(&f.output, "o", "-", "SVG file to write, - for standard output")
+	flag.IntVar(&f.size, "size", 600, "height of the wind rose in pixels")
+	flag.StringVar(&f.baseURL, "baseURL", synopcsv.DefaultBaseURL, "url of the directory to download files from")
+	flag.IntVar(&f.retries, "retries", 3, "how many times to retry a failed download")
+	flag.IntVar(&f.workers, "workers", 4, "number of parallel downloads")
+	flag.DurationVar(&f.interval, "interval", 500*time.Millisecond, "minimal time between the start of two downloads")
+	flag.Parse()
+	return f
+}
+
+func (f flags) check() {
+	if f.station == "" || f.from == "" || f.to == "" {
+		fmt.Fprintf(os.Stderr, "need to provide a station with -station and a range with -from -to\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+}
+
+// parseBins parses comma separated speed bounds
+func parseBins(s string) ([]float64, error) {
+	var bins []float64
+	for _, b := range strings.Split(s, ",") {
+		if b = strings.TrimSpace(b); b == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(b, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid speed bin %q", b)
+		}
+		bins = append(bins, v)
+	}
+	return bins, nil
+}
+
+// buildRose downloads measures between from and to and counts the winds of station
+func buildRose(f flags, from, to time.Time) (*windrose.Rose, error) {
+	bins, err := parseBins(f.bins)
+	if err != nil {
+		return nil, err
+	}
+	r, err := windrose.NewRose(f.sectors, bins)
+	if err != nil {
+		return nil, err
+	}
+	d := &synopcsv.Downloader{
+		Fetcher:  &synopcsv.Fetcher{BaseURL: f.baseURL, Retries: f.retries},
+		Workers:  f.workers,
+		Interval: f.interval,
+	}
+	// stops the downloads when returning before the last batch
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for batch := range d.DownloadRange(ctx, from, to) {
+		if batch.Err != nil {
+			return nil, batch.Err
+		}
+		for _, m := range batch.Measures {
+			if m.StationID == f.station {
+				r.Add(m)
+			}
+		}
+	}
+	return r, nil
+}
+
+func main() {
+	f := newFlags()
+	f.check()
+
+	from, err := time.Parse("200601", f.from)
+	checkError(errors.Wrap(err, "invalid start date"))
+	to, err := time.Parse("200601", f.to)
+	checkError(errors.Wrap(err, "invalid end date"))
+
+	r, err := buildRose(f, from, to)
+	checkError(err)
+	if r.Total == 0 {
+		checkError(errors.Errorf("no wind measure for station %v between %v and %v", f.station, f.from, f.to))
+	}
+
+	title := fmt.Sprintf("Station %v, %v - %v", f.station, from.Format("01/2006"), to.Format("01/2006"))
+	checkError(writeSVG(r, f.output, title, f.size))
+}
+
+// writeSVG writes the wind rose to the output file, or to the standard output for -
+func writeSVG(r *windrose.Rose, output, title string, size int) error {
+	if output == "-" {
+		return r.WriteSVG(os.Stdout, title, size)
+	}
+	file, err := os.Create(output)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.WriteSVG(file, title, size); err != nil {
+		file.Close()
+		return err
+	}
+	return errors.WithStack(file.Close())
+}
@@ -0,0 +1,75 @@
+// Package codes decodes the WMO code tables used in SYNOP measures, with english and french descriptions.
+// Tables are available at https://library.wmo.int/pmb_ged/wmo_306-v1_1-2012_fr.pdf
+// Codes are the values found in Météo-France CSV files, cloud tables being encoded as in BUFR table 020012.
+package codes
+
+import "sort"
+
+// Language of a code description
+type Language int
+
+// Supported languages
+const (
+	English Language = iota
+	French
+)
+
+// Entry is a code and its descriptions
+type Entry struct {
+	Code    int
+	English string
+	French  string
+}
+
+// Text returns the description of the entry in lang
+func (e Entry) Text(lang Language) string {
+	if lang == French {
+		return e.French
+	}
+	return e.English
+}
+
+// Table is a WMO code table
+type Table struct {
+	ID      string // WMO code table number, as "4677"
+	Name    string // english name of the table
+	entries map[int]Entry
+}
+
+func newTable(id string, name string, entries []Entry) *Table {
+	t := &Table{ID: id, Name: name, entries: make(map[int]Entry, len(entries))}
+	for _, e := range entries {
+		t.entries[e.Code] = e
+	}
+	tables[id] = t
+	return t
+}
+
+// Lookup returns the entry for code
+func (t *Table) Lookup(code int) (Entry, bool) {
+	e, ok := t.entries[code]
+	return e, ok
+}
+
+// Text returns the description of code in lang, or an empty string if the code is not in the table
+func (t *Table) Text(code int, lang Language) string {
+	return t.entries[code].Text(lang)
+}
+
+// Entries returns all the entries of the table, sorted by code
+func (t *Table) Entries() []Entry {
+	entries := make([]Entry, 0, len(t.entries))
+	for _, e := range t.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Code < entries[j].Code })
+	return entries
+}
+
+var tables = make(map[string]*Table)
+
+// ByID returns the table with WMO number id, as "0513"
+func ByID(id string) (*Table, bool) {
+	t, ok := tables[id]
+	return t, ok
+}
@@ -0,0 +1,37 @@
+package codes
+
+import "testing"
+
+func TestTables(t *testing.T) {
+	sizes := map[string]int{"0200": 9, "4677": 100, "4561": 10, "0513": 11, "0515": 11, "0509": 11, "3855": 6, "0901": 20, "0500": 11, "3778": 13}
+	for id, size := range sizes {
+		table, ok := ByID(id)
+		if !ok {
+			t.Fatalf("missing table %v", id)
+		}
+		entries := table.Entries()
+		if len(entries) != size {
+			t.Errorf("Invalid number of entries for %v: found %v, expected %v", id, len(entries), size)
+		}
+		for _, e := range entries {
+			if e.English == "" || e.French == "" {
+				t.Errorf("missing description for code %v in %v", e.Code, id)
+			}
+		}
+	}
+	if _, ok := ByID("4678"); ok {
+		t.Error("unexpected table 4678")
+	}
+}
+
+func TestText(t *testing.T) {
+	if text := PresentWeather.Text(63, English); text != "Moderate continuous rain" {
+		t.Errorf("Invalid english text: %v", text)
+	}
+	if text := PresentWeather.Text(63, French); text != "Pluie continue modérée" {
+		t.Errorf("Invalid french text: %v", text)
+	}
+	if text := LowClouds.Text(3, English); text != "" {
+		t.Errorf("Invalid text for unknown code: %v", text)
+	}
+}
@@ -0,0 +1,245 @@
+package codes
+
+// PressureTendency is code table 0200, characteristic of pressure tendency during the three hours preceding the observation (cod_tend)
+var PressureTendency = newTable("0200", "Characteristic of pressure tendency", []Entry{
+	{0, "Increasing, then decreasing; pressure the same or higher than three hours ago", "En hausse, puis en baisse ; pression identique ou supérieure à celle d'il y a trois heures"},
+	{1, "Increasing, then steady, or increasing then increasing more slowly; pressure higher than three hours ago", "En hausse, puis stationnaire, ou en hausse puis en hausse plus lente ; pression supérieure à celle d'il y a trois heures"},
+	{2, "Increasing steadily or unsteadily; pressure higher than three hours ago", "En hausse régulière ou irrégulière ; pression supérieure à celle d'il y a trois heures"},
+	{3, "Decreasing or steady, then increasing, or increasing then increasing more rapidly; pressure higher than three hours ago", "En baisse ou stationnaire, puis en hausse, ou en hausse puis en hausse plus rapide ; pression supérieure à celle d'il y a trois heures"},
+	{4, "Steady; pressure the same as three hours ago", "Stationnaire ; pression identique à celle d'il y a trois heures"},
+	{5, "Decreasing, then increasing; pressure the same or lower than three hours ago", "En baisse, puis en hausse ; pression identique ou inférieure à celle d'il y a trois heures"},
+	{6, "Decreasing, then steady, or decreasing then decreasing more slowly; pressure lower than three hours ago", "En baisse, puis stationnaire, ou en baisse puis en baisse plus lente ; pression inférieure à celle d'il y a trois heures"},
+	{7, "Decreasing steadily or unsteadily; pressure lower than three hours ago", "En baisse régulière ou irrégulière ; pression inférieure à celle d'il y a trois heures"},
+	{8, "Steady or increasing, then decreasing, or decreasing then decreasing more rapidly; pressure lower than three hours ago", "Stationnaire ou en hausse, puis en baisse, ou en baisse puis en baisse plus rapide ; pression inférieure à celle d'il y a trois heures"},
+})
+
+// PresentWeather is code table 4677, present weather reported from a manned station (ww)
+var PresentWeather = newTable("4677", "Present weather", []Entry{
+	{0, "Cloud development not observed or not observable", "Évolution des nuages non observée ou non observable"},
+	{1, "Clouds generally dissolving or becoming less developed", "Nuages en voie de dissipation ou devenant moins épais"},
+	{2, "State of sky on the whole unchanged", "État du ciel dans l'ensemble inchangé"},
+	{3, "Clouds generally forming or developing", "Nuages en voie de formation ou de développement"},
+	{4, "Visibility reduced by smoke", "Visibilité réduite par de la fumée"},
+	{5, "Haze", "Brume sèche"},
+	{6, "Widespread dust in suspension in the air, not raised by wind", "Poussière en suspension généralisée, non soulevée par le vent"},
+	{7, "Dust or sand raised by wind, no well developed whirls nor storm", "Poussière ou sable soulevés par le vent, sans tourbillon bien développé ni tempête"},
+	{8, "Well developed dust or sand whirls, no duststorm or sandstorm", "Tourbillons de poussière ou de sable bien développés, sans tempête de poussière ou de sable"},
+	{9, "Duststorm or sandstorm within sight or at the station during the preceding hour", "Tempête de poussière ou de sable en vue ou à la station au cours de l'heure précédente"},
+	{10, "Mist", "Brume"},
+	{11, "Patches of shallow fog or ice fog", "Bancs de brouillard ou de brouillard glacé mince"},
+	{12, "More or less continuous shallow fog or ice fog", "Couche plus ou moins continue de brouillard ou de brouillard glacé mince"},
+	{13, "Lightning visible, no thunder heard", "Éclairs visibles, tonnerre non entendu"},
+	{14, "Precipitation within sight, not reaching the ground", "Précipitations en vue, n'atteignant pas le sol"},
+	{15, "Precipitation within sight, reaching the ground more than 5 km from the station", "Précipitations en vue, atteignant le sol à plus de 5 km de la station"},
+	{16, "Precipitation within sight, reaching the ground near to but not at the station", "Précipitations en vue, atteignant le sol près de la station mais pas à la station"},
+	{17, "Thunderstorm, but no precipitation at the time of observation", "Orage, sans précipitations au moment de l'observation"},
+	{18, "Squalls at or within sight of the station", "Grains à la station ou en vue de la station"},
+	{19, "Funnel clouds, tornado or waterspout", "Trombes terrestres ou marines"},
+	{20, "Drizzle or snow grains during the preceding hour but not at the time of observation", "Bruine ou neige en grains au cours de l'heure précédente mais non au moment de l'observation"},
+	{21, "Rain during the preceding hour but not at the time of observation", "Pluie au cours de l'heure précédente mais non au moment de l'observation"},
+	{22, "Snow during the preceding hour but not at the time of observation", "Neige au cours de l'heure précédente mais non au moment de l'observation"},
+	{23, "Rain and snow or ice pellets during the preceding hour but not at the time of observation", "Pluie et neige ou granules de glace au cours de l'heure précédente mais non au moment de l'observation"},
+	{24, "Freezing drizzle or freezing rain during the preceding hour but not at the time of observation", "Bruine ou pluie se congelant au cours de l'heure précédente mais non au moment de l'observation"},
+	{25, "Rain showers during the preceding hour but not at the time of observation", "Averses de pluie au cours de l'heure précédente mais non au moment de l'observation"},
+	{26, "Snow showers, or rain and snow showers, during the preceding hour but not at the time of observation", "Averses de neige, ou de pluie et de neige, au cours de l'heure précédente mais non au moment de l'observation"},
+	{27, "Hail showers, or rain and hail showers, during the preceding hour but not at the time of observation", "Averses de grêle, ou de pluie et de grêle, au cours de l'heure précédente mais non au moment de l'observation"},
+	{28, "Fog or ice fog during the preceding hour but not at the time of observation", "Brouillard ou brouillard glacé au cours de l'heure précédente mais non au moment de l'observation"},
+	{29, "Thunderstorm during the preceding hour but not at the time of observation", "Orage au cours de l'heure précédente mais non au moment de l'observation"},
+	{30, "Slight or moderate duststorm or sandstorm, decreasing", "Tempête de poussière ou de sable faible ou modérée, en diminution"},
+	{31, "Slight or moderate duststorm or sandstorm, unchanged", "Tempête de poussière ou de sable faible ou modérée, sans changement"},
+	{32, "Slight or moderate duststorm or sandstorm, beginning or increasing", "Tempête de poussière ou de sable faible ou modérée, commencée ou en augmentation"},
+	{33, "Severe duststorm or sandstorm, decreasing", "Forte tempête de poussière ou de sable, en diminution"},
+	{34, "Severe duststorm or sandstorm, unchanged", "Forte tempête de poussière ou de sable, sans changement"},
+	{35, "Severe duststorm or sandstorm, beginning or increasing", "Forte tempête de poussière ou de sable, commencée ou en augmentation"},
+	{36, "Slight or moderate drifting snow, below eye level", "Chasse-neige basse faible ou modérée"},
+	{37, "Heavy drifting snow, below eye level", "Forte chasse-neige basse"},
+	{38, "Slight or moderate blowing snow, above eye level", "Chasse-neige élevée faible ou modérée"},
+	{39, "Heavy blowing snow, above eye level", "Forte chasse-neige élevée"},
+	{40, "Fog or ice fog at a distance, not at the station during the preceding hour", "Brouillard ou brouillard glacé à distance, pas à la station au cours de l'heure précédente"},
+	{41, "Fog or ice fog in patches", "Brouillard ou brouillard glacé en bancs"},
+	{42, "Fog or ice fog, sky visible, thinning", "Brouillard ou brouillard glacé, ciel visible, s'amincissant"},
+	{43, "Fog or ice fog, sky invisible, thinning", "Brouillard ou brouillard glacé, ciel invisible, s'amincissant"},
+	{44, "Fog or ice fog, sky visible, unchanged", "Brouillard ou brouillard glacé, ciel visible, sans changement"},
+	{45, "Fog or ice fog, sky invisible, unchanged", "Brouillard ou brouillard glacé, ciel invisible, sans changement"},
+	{46, "Fog or ice fog, sky visible, beginning or thickening", "Brouillard ou brouillard glacé, ciel visible, commencé ou s'épaississant"},
+	{47, "Fog or ice fog, sky invisible, beginning or thickening", "Brouillard ou brouillard glacé, ciel invisible, commencé ou s'épaississant"},
+	{48, "Fog depositing rime, sky visible", "Brouillard déposant du givre, ciel visible"},
+	{49, "Fog depositing rime, sky invisible", "Brouillard déposant du givre, ciel invisible"},
+	{50, "Slight intermittent drizzle", "Bruine intermittente faible"},
+	{51, "Slight continuous drizzle", "Bruine continue faible"},
+	{52, "Moderate intermittent drizzle", "Bruine intermittente modérée"},
+	{53, "Moderate continuous drizzle", "Bruine continue modérée"},
+	{54, "Heavy intermittent drizzle", "Bruine intermittente forte"},
+	{55, "Heavy continuous drizzle", "Bruine continue forte"},
+	{56, "Slight freezing drizzle", "Bruine se congelant faible"},
+	{57, "Moderate or heavy freezing drizzle", "Bruine se congelant modérée ou forte"},
+	{58, "Slight drizzle and rain", "Bruine et pluie faibles"},
+	{59, "Moderate or heavy drizzle and rain", "Bruine et pluie modérées ou fortes"},
+	{60, "Slight intermittent rain", "Pluie intermittente faible"},
+	{61, "Slight continuous rain", "Pluie continue faible"},
+	{62, "Moderate intermittent rain", "Pluie intermittente modérée"},
+	{63, "Moderate continuous rain", "Pluie continue modérée"},
+	{64, "Heavy intermittent rain", "Pluie intermittente forte"},
+	{65, "Heavy continuous rain", "Pluie continue forte"},
+	{66, "Slight freezing rain", "Pluie se congelant faible"},
+	{67, "Moderate or heavy freezing rain", "Pluie se congelant modérée ou forte"},
+	{68, "Slight rain or drizzle and snow", "Pluie ou bruine et neige faibles"},
+	{69, "Moderate or heavy rain or drizzle and snow", "Pluie ou bruine et neige modérées ou fortes"},
+	{70, "Slight intermittent snow", "Neige intermittente faible"},
+	{71, "Slight continuous snow", "Neige continue faible"},
+	{72, "Moderate intermittent snow", "Neige intermittente modérée"},
+	{73, "Moderate continuous snow", "Neige continue modérée"},
+	{74, "Heavy intermittent snow", "Neige intermittente forte"},
+	{75, "Heavy continuous snow", "Neige continue forte"},
+	{76, "Diamond dust", "Poudrin de glace"},
+	{77, "Snow grains", "Neige en grains"},
+	{78, "Isolated star-like snow crystals", "Étoiles de neige isolées"},
+	{79, "Ice pellets", "Granules de glace"},
+	{80, "Slight rain showers", "Averses de pluie faibles"},
+	{81, "Moderate or heavy rain showers", "Averses de pluie modérées ou fortes"},
+	{82, "Violent rain showers", "Averses de pluie violentes"},
+	{83, "Slight showers of rain and snow mixed", "Averses de pluie et neige mêlées faibles"},
+	{84, "Moderate or heavy showers of rain and snow mixed", "Averses de pluie et neige mêlées modérées ou fortes"},
+	{85, "Slight snow showers", "Averses de neige faibles"},
+	{86, "Moderate or heavy snow showers", "Averses de neige modérées ou fortes"},
+	{87, "Slight showers of snow pellets or small hail", "Averses de neige roulée ou de grésil faibles"},
+	{88, "Moderate or heavy showers of snow pellets or small hail", "Averses de neige roulée ou de grésil modérées ou fortes"},
+	{89, "Slight hail showers, without thunder", "Averses de grêle faibles, sans tonnerre"},
+	{90, "Moderate or heavy hail showers, without thunder", "Averses de grêle modérées ou fortes, sans tonnerre"},
+	{91, "Slight rain, thunderstorm during the preceding hour", "Pluie faible, orage au cours de l'heure précédente"},
+	{92, "Moderate or heavy rain, thunderstorm during the preceding hour", "Pluie modérée ou forte, orage au cours de l'heure précédente"},
+	{93, "Slight snow, rain and snow mixed or hail, thunderstorm during the preceding hour", "Neige, pluie et neige mêlées ou grêle faibles, orage au cours de l'heure précédente"},
+	{94, "Moderate or heavy snow, rain and snow mixed or hail, thunderstorm during the preceding hour", "Neige, pluie et neige mêlées ou grêle modérées ou fortes, orage au cours de l'heure précédente"},
+	{95, "Slight or moderate thunderstorm with rain or snow, without hail", "Orage faible ou modéré avec pluie ou neige, sans grêle"},
+	{96, "Slight or moderate thunderstorm with hail", "Orage faible ou modéré avec grêle"},
+	{97, "Heavy thunderstorm with rain or snow, without hail", "Orage fort avec pluie ou neige, sans grêle"},
+	{98, "Thunderstorm with duststorm or sandstorm", "Orage avec tempête de poussière ou de sable"},
+	{99, "Heavy thunderstorm with hail", "Orage fort avec grêle"},
+})
+
+// PastWeather is code table 4561, past weather reported from a manned station (w1, w2)
+var PastWeather = newTable("4561", "Past weather", []Entry{
+	{0, "Cloud covering half or less of the sky throughout the period", "Nuages couvrant la moitié du ciel ou moins pendant toute la période"},
+	{1, "Cloud covering more than half of the sky during part of the period and half or less during part of the period", "Nuages couvrant plus de la moitié du ciel pendant une partie de la période et la moitié ou moins pendant l'autre partie"},
+	{2, "Cloud covering more than half of the sky throughout the period", "Nuages couvrant plus de la moitié du ciel pendant toute la période"},
+	{3, "Sandstorm, duststorm or blowing snow", "Tempête de sable, de poussière ou chasse-neige"},
+	{4, "Fog or ice fog or thick haze", "Brouillard, brouillard glacé ou brume sèche épaisse"},
+	{5, "Drizzle", "Bruine"},
+	{6, "Rain", "Pluie"},
+	{7, "Snow, or rain and snow mixed", "Neige, ou pluie et neige mêlées"},
+	{8, "Showers", "Averses"},
+	{9, "Thunderstorm with or without precipitation", "Orage avec ou sans précipitations"},
+})
+
+// LowClouds is code table 0513, clouds of the genera Stratocumulus, Stratus, Cumulus and Cumulonimbus (cl)
+var LowClouds = newTable("0513", "Low clouds", []Entry{
+	{30, "No low clouds", "Pas de nuages bas"},
+	{31, "Cumulus humilis or fractus of fine weather", "Cumulus humilis ou fractus de beau temps"},
+	{32, "Cumulus mediocris or congestus", "Cumulus mediocris ou congestus"},
+	{33, "Cumulonimbus calvus", "Cumulonimbus calvus"},
+	{34, "Stratocumulus cumulogenitus", "Stratocumulus cumulogenitus"},
+	{35, "Stratocumulus not resulting from the spreading out of Cumulus", "Stratocumulus ne résultant pas de l'étalement de Cumulus"},
+	{36, "Stratus nebulosus or fractus of fine weather", "Stratus nebulosus ou fractus de beau temps"},
+	{37, "Stratus fractus or Cumulus fractus of bad weather", "Stratus fractus ou Cumulus fractus de mauvais temps"},
+	{38, "Cumulus and Stratocumulus with bases at different levels", "Cumulus et Stratocumulus à des niveaux différents"},
+	{39, "Cumulonimbus capillatus", "Cumulonimbus capillatus"},
+	{62, "Low clouds not visible owing to darkness, fog, duststorm, sandstorm or other analogous phenomena", "Nuages bas invisibles en raison de l'obscurité, du brouillard, de tempêtes de poussière ou de sable ou d'autres phénomènes analogues"},
+})
+
+// MiddleClouds is code table 0515, clouds of the genera Altocumulus, Altostratus and Nimbostratus (cm)
+var MiddleClouds = newTable("0515", "Middle clouds", []Entry{
+	{20, "No middle clouds", "Pas de nuages moyens"},
+	{21, "Altostratus translucidus", "Altostratus translucidus"},
+	{22, "Altostratus opacus or Nimbostratus", "Altostratus opacus ou Nimbostratus"},
+	{23, "Altocumulus translucidus at a single level", "Altocumulus translucidus à un seul niveau"},
+	{24, "Patches of Altocumulus translucidus, continually changing", "Bancs d'Altocumulus translucidus en évolution continuelle"},
+	{25, "Altocumulus translucidus in bands, progressively invading the sky", "Altocumulus translucidus en bandes, envahissant progressivement le ciel"},
+	{26, "Altocumulus cumulogenitus or cumulonimbogenitus", "Altocumulus cumulogenitus ou cumulonimbogenitus"},
+	{27, "Altocumulus in two or more layers, or with Altostratus or Nimbostratus", "Altocumulus à plusieurs niveaux, ou avec Altostratus ou Nimbostratus"},
+	{28, "Altocumulus castellanus or floccus", "Altocumulus castellanus ou floccus"},
+	{29, "Altocumulus of a chaotic sky", "Altocumulus d'un ciel chaotique"},
+	{61, "Middle clouds not visible owing to darkness, fog or a continuous layer of lower clouds", "Nuages moyens invisibles en raison de l'obscurité, du brouillard ou d'une couche continue de nuages plus bas"},
+})
+
+// HighClouds is code table 0509, clouds of the genera Cirrus, Cirrocumulus and Cirrostratus (ch)
+var HighClouds = newTable("0509", "High clouds", []Entry{
+	{10, "No high clouds", "Pas de nuages élevés"},
+	{11, "Cirrus fibratus, not progressively invading the sky", "Cirrus fibratus, n'envahissant pas progressivement le ciel"},
+	{12, "Dense Cirrus in patches, or Cirrus castellanus or floccus", "Cirrus denses en bancs, ou Cirrus castellanus ou floccus"},
+	{13, "Cirrus spissatus cumulonimbogenitus", "Cirrus spissatus cumulonimbogenitus"},
+	{14, "Cirrus uncinus or fibratus, progressively invading the sky", "Cirrus uncinus ou fibratus, envahissant progressivement le ciel"},
+	{15, "Cirrus and Cirrostratus invading the sky, the veil not reaching 45° above the horizon", "Cirrus et Cirrostratus envahissant le ciel, le voile n'atteignant pas 45° au-dessus de l'horizon"},
+	{16, "Cirrus and Cirrostratus invading the sky, the veil exceeding 45° above the horizon", "Cirrus et Cirrostratus envahissant le ciel, le voile dépassant 45° au-dessus de l'horizon"},
+	{17, "Cirrostratus covering the whole sky", "Cirrostratus couvrant tout le ciel"},
+	{18, "Cirrostratus not invading nor covering the whole sky", "Cirrostratus n'envahissant pas le ciel et ne le couvrant pas entièrement"},
+	{19, "Cirrocumulus alone, or predominant among high clouds", "Cirrocumulus seuls, ou prédominants parmi les nuages élevés"},
+	{60, "High clouds not visible owing to darkness, fog or a continuous layer of lower clouds", "Nuages élevés invisibles en raison de l'obscurité, du brouillard ou d'une couche continue de nuages plus bas"},
+})
+
+// WetBulbIndicator is code table 3855, sign and type of the wet-bulb temperature (sw)
+var WetBulbIndicator = newTable("3855", "Indicator for sign and type of wet-bulb temperature", []Entry{
+	{0, "Positive or zero measured wet-bulb temperature", "Température du thermomètre mouillé mesurée, positive ou nulle"},
+	{1, "Negative measured wet-bulb temperature", "Température du thermomètre mouillé mesurée, négative"},
+	{2, "Iced bulb measured wet-bulb temperature", "Température du thermomètre mouillé mesurée, thermomètre givré"},
+	{5, "Positive or zero computed wet-bulb temperature", "Température du thermomètre mouillé calculée, positive ou nulle"},
+	{6, "Negative computed wet-bulb temperature", "Température du thermomètre mouillé calculée, négative"},
+	{7, "Iced bulb computed wet-bulb temperature", "Température du thermomètre mouillé calculée, thermomètre givré"},
+})
+
+// GroundState is code table 0901, state of the ground without snow, followed by code table 0975 shifted by 10
+// for the state of the ground with snow or measurable ice cover (etat_sol)
+var GroundState = newTable("0901", "State of the ground", []Entry{
+	{0, "Surface of ground dry", "Surface du sol sèche"},
+	{1, "Surface of ground moist", "Surface du sol humide"},
+	{2, "Surface of ground wet, standing water in pools", "Surface du sol mouillée, flaques d'eau"},
+	{3, "Flooded", "Inondé"},
+	{4, "Surface of ground frozen", "Surface du sol gelée"},
+	{5, "Glaze on ground", "Verglas au sol"},
+	{6, "Loose dry dust or sand not covering ground completely", "Poussière ou sable sec ne couvrant pas complètement le sol"},
+	{7, "Thin cover of loose dry dust or sand covering ground completely", "Couche mince de poussière ou de sable sec couvrant complètement le sol"},
+	{8, "Moderate or thick cover of loose dry dust or sand covering ground completely", "Couche moyenne ou épaisse de poussière ou de sable sec couvrant complètement le sol"},
+	{9, "Extremely dry with cracks", "Extrêmement sec avec fissures"},
+	{10, "Ground predominantly covered by ice", "Sol en grande partie couvert de glace"},
+	{11, "Compact or wet snow covering less than one half of the ground", "Neige mouillée ou tassée couvrant moins de la moitié du sol"},
+	{12, "Compact or wet snow covering at least one half of the ground but not completely", "Neige mouillée ou tassée couvrant au moins la moitié du sol sans le couvrir complètement"},
+	{13, "Even layer of compact or wet snow covering ground completely", "Couche uniforme de neige mouillée ou tassée couvrant complètement le sol"},
+	{14, "Uneven layer of compact or wet snow covering ground completely", "Couche irrégulière de neige mouillée ou tassée couvrant complètement le sol"},
+	{15, "Loose dry snow covering less than one half of the ground", "Neige sèche poudreuse couvrant moins de la moitié du sol"},
+	{16, "Loose dry snow covering at least one half of the ground but not completely", "Neige sèche poudreuse couvrant au moins la moitié du sol sans le couvrir complètement"},
+	{17, "Even layer of loose dry snow covering ground completely", "Couche uniforme de neige sèche poudreuse couvrant complètement le sol"},
+	{18, "Uneven layer of loose dry snow covering ground completely", "Couche irrégulière de neige sèche poudreuse couvrant complètement le sol"},
+	{19, "Snow covering ground completely, deep drifts", "Neige couvrant complètement le sol, congères importantes"},
+})
+
+// CloudGenus is code table 0500, genus of cloud of individual cloud layers (ctype1 to ctype4)
+var CloudGenus = newTable("0500", "Genus of cloud", []Entry{
+	{0, "Cirrus (Ci)", "Cirrus (Ci)"},
+	{1, "Cirrocumulus (Cc)", "Cirrocumulus (Cc)"},
+	{2, "Cirrostratus (Cs)", "Cirrostratus (Cs)"},
+	{3, "Altocumulus (Ac)", "Altocumulus (Ac)"},
+	{4, "Altostratus (As)", "Altostratus (As)"},
+	{5, "Nimbostratus (Ns)", "Nimbostratus (Ns)"},
+	{6, "Stratocumulus (Sc)", "Stratocumulus (Sc)"},
+	{7, "Stratus (St)", "Stratus (St)"},
+	{8, "Cumulus (Cu)", "Cumulus (Cu)"},
+	{9, "Cumulonimbus (Cb)", "Cumulonimbus (Cb)"},
+	{59, "Cloud not visible owing to darkness, fog, duststorm, sandstorm or other analogous phenomena", "Nuage invisible en raison de l'obscurité, du brouillard, de tempêtes de poussière ou de sable ou d'autres phénomènes analogues"},
+})
+
+// SpecialPhenomena is code table 3778, kind of special phenomena reported in 9SpSpspsp groups (phenspe1 to phenspe4).
+// Only the kinds decoded by synopcsv are described, groups of other kinds are rejected.
+var SpecialPhenomena = newTable("3778", "Supplementary information", []Entry{
+	{10, "Highest gust during the 10 minutes preceding the observation", "Rafale maximale au cours des 10 minutes précédant l'observation"},
+	{11, "Highest gust during the period covered by past weather", "Rafale maximale au cours de la période couverte par le temps passé"},
+	{12, "Highest mean wind speed during the period covered by past weather", "Vitesse moyenne du vent maximale au cours de la période couverte par le temps passé"},
+	{13, "Mean wind speed during the period covered by past weather", "Vitesse moyenne du vent au cours de la période couverte par le temps passé"},
+	{14, "Lowest mean wind speed during the period covered by past weather", "Vitesse moyenne du vent minimale au cours de la période couverte par le temps passé"},
+	{15, "Direction of the wind", "Direction du vent"},
+	{30, "Amount of precipitation", "Hauteur de précipitations"},
+	{31, "Depth of newly fallen snow", "Épaisseur de neige fraîche"},
+	{32, "Maximum diameter of hailstones", "Diamètre maximal des grêlons"},
+	{34, "Diameter of glaze deposit", "Diamètre du dépôt de verglas"},
+	{35, "Diameter of rime deposit", "Diamètre du dépôt de givre"},
+	{36, "Diameter of compound deposit", "Diamètre du dépôt composé"},
+	{37, "Diameter of wet snow deposit", "Diamètre du dépôt de neige mouillée"},
+})
@@ -0,0 +1,75 @@
+package synopcsv
+
+import (
+	"math"
+
+	"github.com/jfyuen/synopcsv/units"
+)
+
+// humidity returns Humidity, or the relative humidity computed from Temperature and DewPoint when not reported
+func (m Measure) humidity() (float64, bool) {
+	if m.Humidity != nil {
+		return float64(*m.Humidity), true
+	}
+	return m.RelativeHumidity()
+}
+
+// HeatIndex returns the NOAA heat index in K, only defined from 80 °F (26.7 °C).
+// See https://www.wpc.ncep.noaa.gov/html/heatindex_equation.shtml
+func (m Measure) HeatIndex() (float64, bool) {
+	rh, ok := m.humidity()
+	if !ok || m.Temperature == nil {
+		return 0, false
+	}
+	t := units.Fahrenheit.FromKelvin(*m.Temperature)
+	if t < 80 {
+		return 0, false
+	}
+	hi := 0.5 * (t + 61 + (t-68)*1.2 + rh*0.094)
+	if (hi+t)/2 >= 80 {
+		hi = -42.379 + 2.04901523*t + 10.14333127*rh - 0.22475541*t*rh - 0.00683783*t*t -
+			0.05481717*rh*rh + 0.00122874*t*t*rh + 0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+		if rh < 13 && t <= 112 {
+			hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(t-95))/17)
+		} else if rh > 85 && t <= 87 {
+			hi += (rh - 85) / 10 * (87 - t) / 5
+		}
+	}
+	return units.Fahrenheit.ToKelvin(hi), true
+}
+
+// Humidex returns the Canadian humidex in K, only defined from 20 °C.
+// See https://climate.weather.gc.ca/glossary_e.html#humidex
+func (m Measure) Humidex() (float64, bool) {
+	if m.Temperature == nil || m.DewPoint == nil || *m.Temperature < zeroCelsius+20 {
+		return 0, false
+	}
+	e := 6.11 * math.Exp(5417.7530*(1/273.16 - 1 / *m.DewPoint)) // in hPa
+	return *m.Temperature + 0.5555*(e-10), true
+}
+
+// WindChill returns the JAG/TI wind chill temperature in K,
+// only defined for temperatures up to 10 °C and wind speeds from 4.8 km/h
+func (m Measure) WindChill() (float64, bool) {
+	if m.Temperature == nil || m.WindSpeed == nil {
+		return 0, false
+	}
+	t := units.Celsius.FromKelvin(*m.Temperature)
+	v := units.KilometersPerHour.FromMetersPerSecond(*m.WindSpeed)
+	if t > 10 || v < 4.8 {
+		return 0, false
+	}
+	v16 := math.Pow(v, 0.16)
+	return units.Celsius.ToKelvin(13.12 + 0.6215*t - 11.37*v16 + 0.3965*t*v16), true
+}
+
+// ApparentTemperature returns the Steadman apparent temperature in K, without solar radiation,
+// as used by the Australian Bureau of Meteorology
+func (m Measure) ApparentTemperature() (float64, bool) {
+	rh, ok := m.humidity()
+	if !ok || m.Temperature == nil || m.WindSpeed == nil {
+		return 0, false
+	}
+	e := rh / 100 * saturationVapourPressure(*m.Temperature) / 100 // in hPa
+	return *m.Temperature + 0.33*e - 0.70**m.WindSpeed - 4, true
+}
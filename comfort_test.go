@@ -0,0 +1,44 @@
+package synopcsv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jfyuen/synopcsv/units"
+)
+
+func TestComfortIndices(t *testing.T) {
+	celsius := func(c float64) *float64 {
+		k := units.Celsius.ToKelvin(c)
+		return &k
+	}
+	speed := func(v float64) *float64 { return &v }
+	humidity := func(u int) *int { return &u }
+
+	tests := []struct {
+		name     string
+		m        Measure
+		f        func(Measure) (float64, bool)
+		expected float64 // in °C, NaN when not defined
+	}{
+		{"heat index", Measure{Temperature: celsius(32), Humidity: humidity(70)}, Measure.HeatIndex, 40.6},
+		{"heat index below 80 °F", Measure{Temperature: celsius(20), Humidity: humidity(70)}, Measure.HeatIndex, math.NaN()},
+		{"humidex", Measure{Temperature: celsius(30), DewPoint: celsius(15)}, Measure.Humidex, 34.0},
+		{"humidex below 20 °C", Measure{Temperature: celsius(15), DewPoint: celsius(10)}, Measure.Humidex, math.NaN()},
+		{"wind chill", Measure{Temperature: celsius(-10), WindSpeed: speed(20 / 3.6)}, Measure.WindChill, -17.9},
+		{"wind chill without wind", Measure{Temperature: celsius(-10), WindSpeed: speed(1)}, Measure.WindChill, math.NaN()},
+		{"apparent temperature", Measure{Temperature: celsius(25), Humidity: humidity(50), WindSpeed: speed(2)}, Measure.ApparentTemperature, 24.8},
+	}
+	for _, test := range tests {
+		v, ok := test.f(test.m)
+		if math.IsNaN(test.expected) {
+			if ok {
+				t.Errorf("%v should not be defined, got %v", test.name, v)
+			}
+			continue
+		}
+		if c := units.Celsius.FromKelvin(v); !ok || math.Abs(c-test.expected) > 0.2 {
+			t.Errorf("Invalid %v: %v °C, expected %v °C", test.name, c, test.expected)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package synopcsv
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// IsGzip tells if data starts with the gzip magic bytes
+func IsGzip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+// decompressReader decompresses its input on the fly when it is gzip compressed
+type decompressReader struct {
+	in  *bufio.Reader
+	r   io.Reader
+	err error // error opening the gzip stream, returned by every Read as the input was partly consumed
+}
+
+// Decompress returns a reader decompressing in on the fly if it starts with the gzip magic bytes,
+// or returning in unchanged otherwise.
+// Archives such as https://donneespubliques.meteofrance.fr/donnees_libres/Txt/Synop/Archive/synop.201705.csv.gz
+// can then be parsed directly.
+func Decompress(in io.Reader) io.Reader {
+	return &decompressReader{in: bufio.NewReader(in)}
+}
+
+func (d *decompressReader) Read(p []byte) (int, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	if d.r == nil {
+		magic, _ := d.in.Peek(2)
+		if IsGzip(magic) {
+			gz, err := gzip.NewReader(d.in)
+			if err != nil {
+				d.err = errors.WithStack(err)
+				return 0, d.err
+			}
+			d.r = gz
+		} else {
+			d.r = d.in
+		}
+	}
+	return d.r.Read(p)
+}
@@ -0,0 +1,49 @@
+package synopcsv
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+)
+
+func TestParseMeasureCSVGzip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write([]byte(testMeasureCSV)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !IsGzip(buf.Bytes()) || IsGzip([]byte(testMeasureCSV)) {
+		t.Fatal("gzip detection failed")
+	}
+
+	compressed, err := ParseMeasureCSV(buf)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	plain, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(compressed) != len(plain) || compressed[1].StationID != plain[1].StationID {
+		t.Errorf("gzip compressed measures do not match plain ones: %v vs %v", compressed, plain)
+	}
+}
+
+func TestDecompressInvalidHeader(t *testing.T) {
+	// gzip magic bytes with an invalid compression method
+	r := Decompress(bytes.NewReader([]byte{0x1f, 0x8b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 'a', 'b', 'c'}))
+	p := make([]byte, 16)
+	_, err := r.Read(p)
+	if err == nil {
+		t.Fatal("expected an error on an invalid gzip header")
+	}
+	for i := 0; i < 2; i++ {
+		if n, errAgain := r.Read(p); n != 0 || errAgain != err {
+			t.Errorf("expected the first error on later reads, got %v bytes and %v", n, errAgain)
+		}
+	}
+}
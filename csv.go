@@ -9,37 +9,46 @@ import (
 type CSVRow map[string]string
 
 // CSV is a simple CSV structure to hold tabular data
+//
+// Deprecated: files are now read one row at a time, CSV is kept for compatibility and no longer used.
 type CSV struct {
 	headers []string
 	rows    []CSVRow
 }
 
-func parseCSV(in io.Reader) (CSV, error) {
-	r := csv.NewReader(in)
-	r.Comma = ';'
+// csvReader reads a CSV file one row at a time, the returned row is reused between calls
+type csvReader struct {
+	r       *csv.Reader
+	headers []string
+	row     CSVRow
+	line    int // line of the last row read, the header being line 1
+}
 
-	rows := make([]CSVRow, 0)
-	csvVals := CSV{}
+// newCSVReader returns a csvReader on in, decompressing it if gzip compressed
+func newCSVReader(in io.Reader) *csvReader {
+	r := csv.NewReader(Decompress(in))
+	r.Comma = ';'
+	r.ReuseRecord = true
+	return &csvReader{r: r}
+}
 
+// next returns the next row of the file, or io.EOF when there is no more row
+func (c *csvReader) next() (CSVRow, error) {
 	for {
-		record, err := r.Read()
-		if err == io.EOF {
-			break
-		}
+		record, err := c.r.Read()
 		if err != nil {
-			return csvVals, err
+			return nil, err
+		}
+		c.line++
+		if c.headers == nil {
+			c.headers = append([]string(nil), record...)
+			c.row = make(CSVRow, len(c.headers))
+			continue
 		}
-		if len(csvVals.headers) == 0 {
-			csvVals.headers = record
-		} else {
-			columns := make(map[string]string)
-			for i, val := range record {
-				h := csvVals.headers[i]
-				columns[h] = val
-			}
-			rows = append(rows, columns)
+		for i, val := range record {
+			h := c.headers[i]
+			c.row[h] = val
 		}
+		return c.row, nil
 	}
-	csvVals.rows = rows
-	return csvVals, nil
 }
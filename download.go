@@ -0,0 +1,162 @@
+package synopcsv
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Batch holds the measures parsed from a single file
+type Batch struct {
+	Date     string // date of the file, as accepted by FetchMeasureCSV
+	Measures []Measure
+	Err      error
+}
+
+// Downloader fetches and parses measure files concurrently, for multi-year backfills
+type Downloader struct {
+	Fetcher  *Fetcher      // defaults to DefaultFetcher
+	Workers  int           // number of parallel downloads, defaults to 4
+	Interval time.Duration // minimal time between the start of two downloads, to stay polite toward the server
+	// Open returns the content of the file at date, defaults to Fetcher.FetchMeasureCSV.
+	// A returned io.Closer is closed once parsed, so Open may serve files from a local cache.
+	Open func(ctx context.Context, date string) (io.Reader, error)
+}
+
+type downloadJob struct {
+	date  string
+	batch chan Batch
+}
+
+func (d *Downloader) workers() int {
+	if d.Workers <= 0 {
+		return 4
+	}
+	return d.Workers
+}
+
+func (d *Downloader) open(ctx context.Context, date string) (io.Reader, error) {
+	if d.Open != nil {
+		return d.Open(ctx, date)
+	}
+	f := d.Fetcher
+	if f == nil {
+		f = DefaultFetcher
+	}
+	return f.FetchMeasureCSV(ctx, date)
+}
+
+// Download fetches and parses the files at dates, sending one batch per file in the order of dates.
+// At most Workers files are downloaded or waiting to be received at once.
+// The returned channel is closed after the last batch, or early when ctx is canceled.
+// Callers must receive until the channel is closed, or cancel ctx when they stop early, otherwise downloading goroutines block forever.
+func (d *Downloader) Download(ctx context.Context, dates []string) <-chan Batch {
+	return d.download(ctx, dates, func(Measure) bool { return true })
+}
+
+// DownloadRange downloads the files holding measures from from included to to excluded as selected by Fetcher.MeasureFiles,
+// batches only holding the measures in this range. As for Download, callers must receive every batch or cancel ctx.
+func (d *Downloader) DownloadRange(ctx context.Context, from, to time.Time) <-chan Batch {
+	f := d.Fetcher
+	if f == nil {
+		f = DefaultFetcher
+	}
+	keep := func(m Measure) bool {
+		return !m.Date.Before(from) && m.Date.Before(to)
+	}
+	return d.download(ctx, f.MeasureFiles(from, to), keep)
+}
+
+func (d *Downloader) download(ctx context.Context, dates []string, keep func(Measure) bool) <-chan Batch {
+	jobs := make(chan downloadJob)
+	// pending holds the batches being downloaded in the order of dates, bounding how far ahead workers can go
+	pending := make(chan chan Batch, d.workers())
+	out := make(chan Batch)
+
+	for i := 0; i < d.workers(); i++ {
+		go func() {
+			for job := range jobs {
+				job.batch <- d.fetch(ctx, job.date, keep)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+		var tick <-chan time.Time
+		if d.Interval > 0 {
+			ticker := time.NewTicker(d.Interval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+		for i, date := range dates {
+			if tick != nil && i > 0 {
+				select {
+				case <-tick:
+				case <-ctx.Done():
+					return
+				}
+			}
+			job := downloadJob{date: date, batch: make(chan Batch, 1)}
+			select {
+			case pending <- job.batch:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		for batch := range pending {
+			var b Batch
+			select {
+			case b = <-batch:
+			case <-ctx.Done():
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case out <- b:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (d *Downloader) fetch(ctx context.Context, date string, keep func(Measure) bool) Batch {
+	batch := Batch{Date: date}
+	in, err := d.open(ctx, date)
+	if err != nil {
+		batch.Err = err
+		return batch
+	}
+	if c, ok := in.(io.Closer); ok {
+		defer c.Close()
+	}
+	r := NewMeasureReader(in)
+	measures := make([]Measure, 0)
+	for r.Next() {
+		if m := r.Measure(); keep(m) {
+			measures = append(measures, m)
+		}
+	}
+	if err := r.Err(); err != nil {
+		batch.Err = errors.Wrapf(err, "error parsing measures for %v", date)
+		return batch
+	}
+	batch.Measures = measures
+	return batch
+}
@@ -0,0 +1,67 @@
+package synopcsv
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDownloaderOrder(t *testing.T) {
+	dates := []string{"2017050100", "2017050103", "2017050106", "2017050109", "2017050112"}
+	var running, maxRunning int32
+	d := &Downloader{
+		Workers: 3,
+		Open: func(ctx context.Context, date string) (io.Reader, error) {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			// later files are downloaded faster
+			at, _ := time.Parse("2006010215", date)
+			time.Sleep(time.Duration(15-at.Hour()) * time.Millisecond)
+			return strings.NewReader(strings.Replace(testMeasureCSV, "20170501000000", at.Format("20060102150405"), -1)), nil
+		},
+	}
+
+	i := 0
+	for batch := range d.Download(context.Background(), dates) {
+		if batch.Err != nil {
+			t.Fatalf("%+v", batch.Err)
+		}
+		if batch.Date != dates[i] || len(batch.Measures) != 2 || batch.Measures[0].Date.Format("2006010215") != dates[i] {
+			t.Errorf("Invalid batch %v: %v", i, batch)
+		}
+		i++
+	}
+	if i != len(dates) {
+		t.Errorf("Invalid number of batches: found %v, expected %v", i, len(dates))
+	}
+	if maxRunning > 3 {
+		t.Errorf("Too many parallel downloads: %v", maxRunning)
+	}
+}
+
+func TestDownloaderCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := &Downloader{
+		Open: func(ctx context.Context, date string) (io.Reader, error) {
+			return strings.NewReader(testMeasureCSV), nil
+		},
+	}
+	count := 0
+	for range d.Download(ctx, []string{"201701", "201702", "201703", "201704", "201705", "201706"}) {
+		count++
+		cancel()
+	}
+	if count >= 6 {
+		t.Errorf("Download did not stop on cancel")
+	}
+}
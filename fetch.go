@@ -0,0 +1,239 @@
+package synopcsv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultBaseURL is the Météo-France directory where SYNOP files are published
+const DefaultBaseURL = "https://donneespubliques.meteofrance.fr/donnees_libres/Txt/Synop/"
+
+var defaultClient = &http.Client{
+	Timeout: time.Second * 10,
+}
+
+// Publication delays of Météo-France files
+const (
+	DefaultDelay        = 2 * time.Hour  // from the time of the measures of a 3-hourly file
+	DefaultArchiveDelay = 48 * time.Hour // from the end of the month of an archive
+)
+
+// DefaultFetcher is the Fetcher used by FetchStationCSV and FetchMeasureCSV
+var DefaultFetcher = &Fetcher{}
+
+// Fetcher downloads SYNOP files, its zero value fetches from DefaultBaseURL without retrying
+type Fetcher struct {
+	Client    *http.Client  // defaults to a client with a 10 seconds timeout
+	BaseURL   string        // directory holding postesSynop.csv and the Archive directory, defaults to DefaultBaseURL
+	UserAgent string        // sent with each request when not empty
+	Retries   int           // how many times to retry on network errors, 5xx and 429 responses
+	Backoff   time.Duration // wait before the first retry, doubled for each following one up to MaxBackoff, defaults to 1 second
+	// Now returns the current time, telling which files are published, defaults to time.Now
+	Now func() time.Time
+	// Delay is the time needed to publish a 3-hourly file after its measures, defaults to DefaultDelay, none if negative
+	Delay time.Duration
+	// ArchiveDelay is the time needed to publish a monthly archive after the end of the month, defaults to DefaultArchiveDelay, none if negative.
+	// Measures of a month whose archive is not published yet are read from 3-hourly files.
+	ArchiveDelay time.Duration
+}
+
+func (f *Fetcher) client() *http.Client {
+	if f.Client == nil {
+		return defaultClient
+	}
+	return f.Client
+}
+
+func (f *Fetcher) now() time.Time {
+	if f.Now == nil {
+		return time.Now()
+	}
+	return f.Now()
+}
+
+// delay returns d, or def if d is 0, or 0 if d is negative
+func delay(d, def time.Duration) time.Duration {
+	switch {
+	case d == 0:
+		return def
+	case d < 0:
+		return 0
+	}
+	return d
+}
+
+func (f *Fetcher) url(name string) string {
+	baseURL := f.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	return baseURL + name
+}
+
+// MaxBackoff is the longest wait between two retries, unless Fetcher.Backoff is longer
+const MaxBackoff = 5 * time.Minute
+
+func (f *Fetcher) backoff(attempt int) time.Duration {
+	wait := f.Backoff
+	if wait == 0 {
+		wait = time.Second
+	}
+	if wait >= MaxBackoff {
+		return wait
+	}
+	for i := 0; i < attempt && wait < MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > MaxBackoff {
+		return MaxBackoff
+	}
+	return wait
+}
+
+// retryable tells if a request returning this status may succeed later
+func retryable(status int) bool {
+	return status >= 500 || status == http.StatusTooManyRequests
+}
+
+func (f *Fetcher) fetchURL(ctx context.Context, url string) (io.Reader, error) {
+	var err error
+	for attempt := 0; ; attempt++ {
+		var r io.Reader
+		var retry bool
+		r, retry, err = f.fetchOnce(ctx, url)
+		if err == nil || !retry || attempt >= f.Retries {
+			return r, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.WithStack(ctx.Err())
+		case <-time.After(f.backoff(attempt)):
+		}
+	}
+}
+
+// fetchOnce reads the whole content at url, telling on error if the request is worth retrying
+func (f *Fetcher) fetchOnce(ctx context.Context, url string) (r io.Reader, retry bool, err error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+	req = req.WithContext(ctx)
+	if f.UserAgent != "" {
+		req.Header.Set("User-Agent", f.UserAgent)
+	}
+	response, err := f.client().Do(req)
+	if err != nil {
+		return nil, ctx.Err() == nil, errors.WithStack(err)
+	}
+	defer func() {
+		errClose := response.Body.Close()
+		if err == nil {
+			err = errors.WithStack(errClose)
+		}
+	}()
+	if response.StatusCode != http.StatusOK {
+		// draining the body lets the connection be reused by retries
+		if _, err := io.Copy(ioutil.Discard, response.Body); err != nil {
+			return nil, ctx.Err() == nil, errors.WithStack(err)
+		}
+		return nil, retryable(response.StatusCode), errors.Errorf("unexpected status %v fetching %v", response.Status, url)
+	}
+	b, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, ctx.Err() == nil, errors.WithStack(err)
+	}
+	return bytes.NewReader(b), false, nil
+}
+
+// FetchStationCSV retrieves station lists as csv from postesSynop.csv
+func (f *Fetcher) FetchStationCSV(ctx context.Context) (io.Reader, error) {
+	return f.fetchURL(ctx, f.url("postesSynop.csv"))
+}
+
+// FetchMeasureCSV retrieves past measures in CSV format from Archive/synop.${DATE}.csv.gz
+// with ${DATE} a string as YYYYMM, or from synop.${DATE}.csv with ${DATE} a string as YYYYMMDDHH
+func (f *Fetcher) FetchMeasureCSV(ctx context.Context, date string) (io.Reader, error) {
+	switch len(date) {
+	case 6:
+		return f.fetchURL(ctx, f.url(fmt.Sprintf("Archive/synop.%v.csv.gz", date)))
+	case 10:
+		return f.fetchURL(ctx, f.url(fmt.Sprintf("synop.%v.csv", date)))
+	default:
+		return nil, errors.Errorf("wrong date size: %v", date)
+	}
+}
+
+// measureInterval is the time between two 3-hourly synop files
+const measureInterval = 3 * time.Hour
+
+// MeasureFiles returns the dates, as accepted by FetchMeasureCSV, of the files holding measures from from included to to excluded:
+// monthly archives already published, and 3-hourly files already published for the following months
+func (f *Fetcher) MeasureFiles(from, to time.Time) []string {
+	from, to = from.UTC(), to.UTC()
+	now := f.now().UTC()
+	published := now.Add(-delay(f.Delay, DefaultDelay))
+	archived := now.Add(-delay(f.ArchiveDelay, DefaultArchiveDelay))
+	// first month whose archive is not published yet
+	unarchived := time.Date(archived.Year(), archived.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	dates := make([]string, 0)
+	for m := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC); m.Before(to); m = m.AddDate(0, 1, 0) {
+		if m.Before(unarchived) {
+			dates = append(dates, m.Format("200601"))
+			continue
+		}
+		start := from.Truncate(measureInterval)
+		if start.Before(from) {
+			start = start.Add(measureInterval)
+		}
+		if start.Before(m) {
+			start = m
+		}
+		end := m.AddDate(0, 1, 0)
+		for d := start; d.Before(to) && d.Before(end) && !d.After(published); d = d.Add(measureInterval) {
+			dates = append(dates, d.Format("2006010215"))
+		}
+	}
+	return dates
+}
+
+// FetchRange fetches and parses measures from from included to to excluded,
+// using monthly archives for past months and 3-hourly files for the current one.
+// Measures not published yet, as told by Delay and ArchiveDelay, are left out.
+func (f *Fetcher) FetchRange(ctx context.Context, from, to time.Time) ([]Measure, error) {
+	measures := make([]Measure, 0)
+	for _, date := range f.MeasureFiles(from, to) {
+		in, err := f.FetchMeasureCSV(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+		r := NewMeasureReader(in)
+		for r.Next() {
+			m := r.Measure()
+			if !m.Date.Before(from) && m.Date.Before(to) {
+				measures = append(measures, m)
+			}
+		}
+		if err := r.Err(); err != nil {
+			return nil, errors.Wrapf(err, "error parsing measures for %v", date)
+		}
+	}
+	return measures, nil
+}
+
+// FetchRange fetches and parses measures from from included to to excluded using DefaultFetcher
+func FetchRange(ctx context.Context, from, to time.Time) ([]Measure, error) {
+	return DefaultFetcher.FetchRange(ctx, from, to)
+}
@@ -0,0 +1,201 @@
+package synopcsv
+
+import (
+	"context"
+	"io/fs"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/jfyuen/synopcsv/synopcsvtest"
+)
+
+func TestFetcherRetries(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.UserAgent() != "synopcsv-test" {
+			t.Errorf("Invalid user agent: %v", r.UserAgent())
+		}
+		switch {
+		case r.URL.Path != "/Synop/synop.2017050100.csv":
+			http.NotFound(w, r)
+		case calls < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(testMeasureCSV))
+		}
+	}))
+	defer server.Close()
+
+	f := &Fetcher{BaseURL: server.URL + "/Synop", UserAgent: "synopcsv-test", Retries: 2, Backoff: time.Millisecond}
+	r, err := f.FetchMeasureCSV(context.Background(), "2017050100")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil || string(b) != testMeasureCSV || calls != 3 {
+		t.Fatalf("Invalid content after %v calls: %v %v", calls, string(b), err)
+	}
+
+	calls = 0
+	if _, err := f.FetchMeasureCSV(context.Background(), "201705"); err == nil || calls != 1 {
+		t.Fatalf("expected a single failing call on not found, got %v calls: %v", calls, err)
+	}
+}
+
+func TestFetcherContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f := &Fetcher{BaseURL: server.URL, Retries: 5, Backoff: time.Hour}
+	if _, err := f.FetchStationCSV(ctx); err == nil {
+		t.Fatal("expected an error on canceled context")
+	}
+}
+
+func TestMeasureFiles(t *testing.T) {
+	now := time.Date(2017, 5, 3, 7, 30, 0, 0, time.UTC)
+	f := &Fetcher{Now: func() time.Time { return now }}
+	from := time.Date(2017, 3, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 5, 1, 6, 0, 0, 0, time.UTC)
+	expected := []string{"201703", "201704", "2017050100", "2017050103"}
+	if dates := f.MeasureFiles(from, to); !reflect.DeepEqual(dates, expected) {
+		t.Errorf("Invalid files: %v, expected %v", dates, expected)
+	}
+
+	from = time.Date(2017, 5, 1, 1, 0, 0, 0, time.UTC)
+	expected = []string{"2017050103"}
+	if dates := f.MeasureFiles(from, to); !reflect.DeepEqual(dates, expected) {
+		t.Errorf("Invalid files: %v, expected %v", dates, expected)
+	}
+
+	// the 06h file and the april archive are not published yet
+	now = time.Date(2017, 5, 1, 7, 30, 0, 0, time.UTC)
+	from = time.Date(2017, 4, 30, 18, 0, 0, 0, time.UTC)
+	to = time.Date(2017, 5, 2, 0, 0, 0, 0, time.UTC)
+	expected = []string{"2017043018", "2017043021", "2017050100", "2017050103"}
+	if dates := f.MeasureFiles(from, to); !reflect.DeepEqual(dates, expected) {
+		t.Errorf("Invalid files: %v, expected %v", dates, expected)
+	}
+	f.Delay, f.ArchiveDelay = -1, -1
+	expected = []string{"201704", "2017050100", "2017050103", "2017050106"}
+	if dates := f.MeasureFiles(from, to); !reflect.DeepEqual(dates, expected) {
+		t.Errorf("Invalid files without delay: %v, expected %v", dates, expected)
+	}
+}
+
+func TestFetchRange(t *testing.T) {
+	server := synopcsvtest.NewFixtureServer()
+	defer server.Close()
+
+	now := time.Date(2017, 5, 10, 0, 0, 0, 0, time.UTC)
+	f := &Fetcher{BaseURL: server.BaseURL, Now: func() time.Time { return now }}
+	from := time.Date(2017, 4, 30, 23, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 5, 1, 3, 0, 0, 0, time.UTC)
+	if _, err := f.FetchRange(context.Background(), from, to); err == nil {
+		t.Fatal("expected an error on missing archive")
+	}
+
+	from = time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC)
+	measures, err := f.FetchRange(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(measures) != 57 || measures[0].Date != from {
+		t.Errorf("Invalid measures from 3-hourly files: %v", len(measures))
+	}
+
+	now = time.Date(2017, 6, 10, 0, 0, 0, 0, time.UTC)
+	from = time.Date(2017, 5, 1, 1, 0, 0, 0, time.UTC)
+	to = time.Date(2017, 5, 1, 6, 0, 0, 0, time.UTC)
+	measures, err = f.FetchRange(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, m := range measures {
+		if m.Date != time.Date(2017, 5, 1, 3, 0, 0, 0, time.UTC) {
+			t.Fatalf("Measure out of range from archive: %v", m.Date)
+		}
+	}
+	if len(measures) != 3 {
+		t.Errorf("Invalid number of measures from archive: found %v, expected 3", len(measures))
+	}
+}
+
+func TestFetchRangeNotPublished(t *testing.T) {
+	data, err := fs.ReadFile(synopcsvtest.Fixtures(), "synop.2017050100.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := synopcsvtest.NewFSServer(fstest.MapFS{"synop.2017050100.csv": {Data: data}})
+	defer server.Close()
+
+	// the 03h file is not published yet
+	now := time.Date(2017, 5, 1, 3, 30, 0, 0, time.UTC)
+	f := &Fetcher{BaseURL: server.BaseURL, Now: func() time.Time { return now }}
+	measures, err := f.FetchRange(context.Background(), time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC), now)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(measures) != 57 {
+		t.Errorf("Invalid number of measures %v, expected 57", len(measures))
+	}
+}
+
+func TestFetcherBackoff(t *testing.T) {
+	f := &Fetcher{Backoff: time.Second}
+	if b := f.backoff(0); b != time.Second {
+		t.Errorf("Invalid first backoff %v", b)
+	}
+	if b := f.backoff(2); b != 4*time.Second {
+		t.Errorf("Invalid third backoff %v", b)
+	}
+	for _, attempt := range []int{20, 63, 64, 1000} {
+		if b := f.backoff(attempt); b != MaxBackoff {
+			t.Errorf("Invalid backoff %v for attempt %v, expected %v", b, attempt, MaxBackoff)
+		}
+	}
+	f.Backoff = time.Hour
+	if b := f.backoff(100); b != time.Hour {
+		t.Errorf("Invalid backoff %v longer than MaxBackoff", b)
+	}
+}
+
+func TestFetcherReusesConnections(t *testing.T) {
+	calls := 0
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, strings.Repeat("unavailable ", 1000), http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(testMeasureCSV))
+	}))
+	connections := 0
+	server.Config.ConnState = func(c net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections++
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	f := &Fetcher{BaseURL: server.URL, Retries: 2, Backoff: time.Millisecond}
+	if _, err := f.FetchStationCSV(context.Background()); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if connections != 1 {
+		t.Errorf("Expected retries to reuse the connection, got %v connections", connections)
+	}
+}
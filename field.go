@@ -0,0 +1,95 @@
+package synopcsv
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Field describes a Measure field from its synop struct tag
+type Field struct {
+	Name   string // Go field name
+	Column string // CSV column name
+	Unit   string // unit of the value, empty for codes
+	Code   string // WMO code table of the value, empty if not a code
+	index  int
+	// checked tells if values are validated against the code table when parsing,
+	// the unchecked tag option keeping the table as metadata for fields historically parsed as plain integers
+	checked bool
+}
+
+var (
+	intPtrType        = reflect.TypeOf((*int)(nil))
+	floatPtrType      = reflect.TypeOf((*float64)(nil))
+	stringPtrType     = reflect.TypeOf((*string)(nil))
+	phenomenonPtrType = reflect.TypeOf((*SpecialPhenomenon)(nil))
+	stringType        = reflect.TypeOf("")
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// measureFields holds the tagged Measure fields in CSV column order
+var measureFields = parseFieldTags(reflect.TypeOf(Measure{}))
+
+// parseFieldTags reads the synop tags of t, panicking on invalid ones as they are a programming error
+func parseFieldTags(t reflect.Type) []Field {
+	fields := make([]Field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("synop")
+		if !ok {
+			continue
+		}
+		switch sf.Type {
+		case intPtrType, floatPtrType, stringPtrType, phenomenonPtrType, stringType, timeType:
+		default:
+			panic(fmt.Sprintf("unsupported type %v for field %v", sf.Type, sf.Name))
+		}
+		parts := strings.Split(tag, ",")
+		f := Field{Name: sf.Name, Column: parts[0], index: i}
+		unchecked := false
+		for _, option := range parts[1:] {
+			kv := strings.SplitN(option, "=", 2)
+			switch {
+			case len(kv) == 2 && kv[0] == "unit":
+				f.Unit = kv[1]
+			case len(kv) == 2 && kv[0] == "code":
+				f.Code = kv[1]
+			case option == "unchecked":
+				unchecked = true
+			default:
+				panic(fmt.Sprintf("invalid synop tag option %q for field %v", option, sf.Name))
+			}
+		}
+		f.checked = f.Code != "" && !unchecked
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// MeasureFields returns the description of all Measure fields, in CSV column order
+func MeasureFields() []Field {
+	return append([]Field(nil), measureFields...)
+}
+
+// MeasureField returns the description of the Measure field read from column
+func MeasureField(column string) (Field, bool) {
+	for _, f := range measureFields {
+		if f.Column == column {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// Value returns the value of the field in m: an int, a float64, a string, a SpecialPhenomenon or a time.Time, or nil when not available
+func (f Field) Value(m Measure) interface{} {
+	v := reflect.ValueOf(m).Field(f.index)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	return v.Interface()
+}
@@ -0,0 +1,60 @@
+package synopcsv
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMeasureFields(t *testing.T) {
+	header := strings.SplitN(testMeasureCSV, "\n", 2)[0]
+	columns := strings.Split(strings.TrimSuffix(header, ";"), ";")
+	fields := MeasureFields()
+	if len(fields) != len(columns) {
+		t.Fatalf("Invalid number of fields: found %v, expected %v", len(fields), len(columns))
+	}
+	for i, f := range fields {
+		if f.Column != columns[i] {
+			t.Errorf("Invalid column for %v: found %v, expected %v", f.Name, f.Column, columns[i])
+		}
+	}
+
+	f, ok := MeasureField("pmer")
+	if !ok || f.Name != "SeaPressure" || f.Unit != "Pa" || f.Code != "" {
+		t.Errorf("Invalid pmer field: %+v", f)
+	}
+	f, ok = MeasureField("cl")
+	if !ok || f.Name != "LowerLevelCloudType" || f.Unit != "" || f.Code != "0513" {
+		t.Errorf("Invalid cl field: %+v", f)
+	}
+
+	measures, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if v := f.Value(measures[0]); v != 35 {
+		t.Errorf("Invalid cl value: %v", v)
+	}
+	if f, _ := MeasureField("cm"); f.Value(measures[0]) != nil {
+		t.Errorf("cm should not be available: %v", f.Value(measures[0]))
+	}
+}
+
+func TestUncheckedCodeFields(t *testing.T) {
+	if f, ok := MeasureField("ww"); !ok || f.Code != "4677" || f.checked {
+		t.Errorf("Invalid ww field: %+v", f)
+	}
+	if f, ok := MeasureField("cl"); !ok || !f.checked {
+		t.Errorf("Invalid cl field: %+v", f)
+	}
+	// values out of their code table, as parsed before fields had code tables
+	in := strings.Replace(testMeasureCSV, "07005;20170501000000;101650;-30;8;250;2.100000;283.450000;281.650000;88;20000;2;0;0;",
+		"07005;20170501000000;101650;-30;8;250;2.100000;283.450000;281.650000;88;20000;100;10;12;", 1)
+	measures, err := ParseMeasureCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	m := measures[0]
+	if *m.PresentTime != 100 || *m.PastTime1 != 10 || *m.PastTime2 != 12 {
+		t.Errorf("Invalid unchecked codes: %v %v %v", *m.PresentTime, *m.PastTime1, *m.PastTime2)
+	}
+}
@@ -0,0 +1,67 @@
+// Package geojson encodes GeoJSON documents as defined by RFC 7946, https://tools.ietf.org/html/rfc7946
+package geojson
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Position is a longitude, a latitude in degrees (WGS 84) and an optional altitude in m
+type Position []float64
+
+// Geometry is a GeoJSON geometry object
+type Geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// NewPoint returns a Point geometry at p
+func NewPoint(p Position) *Geometry {
+	return &Geometry{Type: "Point", Coordinates: p}
+}
+
+// NewLineString returns a LineString geometry through positions
+func NewLineString(positions []Position) *Geometry {
+	return &Geometry{Type: "LineString", Coordinates: positions}
+}
+
+// NewMultiLineString returns a MultiLineString geometry made of lines
+func NewMultiLineString(lines [][]Position) *Geometry {
+	return &Geometry{Type: "MultiLineString", Coordinates: lines}
+}
+
+// Feature is a GeoJSON feature object
+type Feature struct {
+	Type       string                 `json:"type"`
+	ID         interface{}            `json:"id,omitempty"`
+	Geometry   *Geometry              `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// NewFeature returns a feature of geometry g, with no properties
+func NewFeature(g *Geometry) *Feature {
+	return &Feature{Type: "Feature", Geometry: g, Properties: make(map[string]interface{})}
+}
+
+// FeatureCollection is a GeoJSON feature collection object
+type FeatureCollection struct {
+	Type     string     `json:"type"`
+	Features []*Feature `json:"features"`
+}
+
+// NewFeatureCollection returns an empty feature collection
+func NewFeatureCollection() *FeatureCollection {
+	return &FeatureCollection{Type: "FeatureCollection", Features: make([]*Feature, 0)}
+}
+
+// Add appends features to the collection
+func (fc *FeatureCollection) Add(features ...*Feature) {
+	fc.Features = append(fc.Features, features...)
+}
+
+// Write encodes the collection as JSON to out
+func (fc *FeatureCollection) Write(out io.Writer) error {
+	return errors.WithStack(json.NewEncoder(out).Encode(fc))
+}
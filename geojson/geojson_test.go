@@ -0,0 +1,54 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFeatureCollectionWrite(t *testing.T) {
+	fc := NewFeatureCollection()
+	p := NewFeature(NewPoint(Position{2.384333, 48.716833, 89}))
+	p.ID = "07149"
+	p.Properties["name"] = "ORLY"
+	fc.Add(p, NewFeature(NewLineString([]Position{{0, 0}, {1, 1}})))
+
+	buf := new(bytes.Buffer)
+	if err := fc.Write(buf); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"type": "FeatureCollection",
+		"features": []interface{}{
+			map[string]interface{}{
+				"type":       "Feature",
+				"id":         "07149",
+				"geometry":   map[string]interface{}{"type": "Point", "coordinates": []interface{}{2.384333, 48.716833, 89.0}},
+				"properties": map[string]interface{}{"name": "ORLY"},
+			},
+			map[string]interface{}{
+				"type":       "Feature",
+				"geometry":   map[string]interface{}{"type": "LineString", "coordinates": []interface{}{[]interface{}{0.0, 0.0}, []interface{}{1.0, 1.0}}},
+				"properties": map[string]interface{}{},
+			},
+		},
+	}
+	if !reflect.DeepEqual(decoded, expected) {
+		t.Errorf("Invalid GeoJSON: %v", buf.String())
+	}
+}
+
+func TestEmptyFeatureCollection(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := NewFeatureCollection().Write(buf); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if s := buf.String(); s != `{"type":"FeatureCollection","features":[]}`+"\n" {
+		t.Errorf("Invalid empty collection %v", s)
+	}
+}
@@ -0,0 +1,176 @@
+package synopcsv
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfyuen/synopcsv/geojson"
+	"github.com/pkg/errors"
+)
+
+// position returns the GeoJSON position of s
+func (s Station) position() geojson.Position {
+	return geojson.Position{s.Longitude, s.Latitude, s.Altitude}
+}
+
+// stationFeature returns s as a GeoJSON point feature
+func stationFeature(s Station) *geojson.Feature {
+	f := geojson.NewFeature(geojson.NewPoint(s.position()))
+	f.ID = s.ID
+	f.Properties["id"] = s.ID
+	f.Properties["name"] = s.Name
+	f.Properties["altitude"] = s.Altitude
+	return f
+}
+
+// jsonValue returns v as a GeoJSON property value
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case SpecialPhenomenon:
+		return v.String()
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return v
+	}
+}
+
+// statusName returns the name of the status of a field, as "not_observable", written by exports for not observable and invalid fields
+func statusName(s Status) string {
+	return strings.Replace(s.String(), " ", "_", -1)
+}
+
+// joinStations returns stations by ID
+func joinStations(stations []Station) map[string]Station {
+	byID := make(map[string]Station, len(stations))
+	for _, s := range stations {
+		byID[s.ID] = s
+	}
+	return byID
+}
+
+// StationsGeoJSON returns stations as a GeoJSON feature collection of points
+func StationsGeoJSON(stations []Station) *geojson.FeatureCollection {
+	fc := geojson.NewFeatureCollection()
+	for _, s := range stations {
+		fc.Add(stationFeature(s))
+	}
+	return fc
+}
+
+// MeasuresGeoJSON returns measures as a GeoJSON feature collection of points at their station, with fields as properties named after their CSV column.
+// Not reported fields are left out, not observable and invalid ones are null with their status in a "<column>_status" property.
+// Measures of stations not in stations are left out.
+func MeasuresGeoJSON(measures []Measure, stations []Station) *geojson.FeatureCollection {
+	byID := joinStations(stations)
+	fc := geojson.NewFeatureCollection()
+	for _, m := range measures {
+		s, ok := byID[m.StationID]
+		if !ok {
+			continue
+		}
+		f := geojson.NewFeature(geojson.NewPoint(s.position()))
+		f.Properties["name"] = s.Name
+		for _, field := range measureFields {
+			if v := field.Value(m); v != nil {
+				f.Properties[field.Column] = jsonValue(v)
+			} else if status := field.Status(m); status != NotReported {
+				f.Properties[field.Column] = nil
+				f.Properties[field.Column+"_status"] = statusName(status)
+			}
+		}
+		fc.Add(f)
+	}
+	return fc
+}
+
+// WriteStationsGeoJSON writes stations as a GeoJSON feature collection
+func WriteStationsGeoJSON(out io.Writer, stations []Station) error {
+	return StationsGeoJSON(stations).Write(out)
+}
+
+// WriteMeasuresGeoJSON writes measures joined to stations as a GeoJSON feature collection
+func WriteMeasuresGeoJSON(out io.Writer, measures []Measure, stations []Station) error {
+	return MeasuresGeoJSON(measures, stations).Write(out)
+}
+
+// kml is a KML 2.2 document, see https://developers.google.com/kml/documentation/kmlreference
+type kml struct {
+	XMLName    xml.Name       `xml:"http://www.opengis.net/kml/2.2 kml"`
+	Name       string         `xml:"Document>name"`
+	Placemarks []kmlPlacemark `xml:"Document>Placemark"`
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type kmlPlacemark struct {
+	Name        string    `xml:"name"`
+	When        string    `xml:"TimeStamp>when,omitempty"`
+	Data        []kmlData `xml:"ExtendedData>Data"`
+	Coordinates string    `xml:"Point>coordinates"`
+}
+
+// newPlacemark returns a placemark at s named name
+func newPlacemark(s Station, name string) kmlPlacemark {
+	formatFloat := func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+	return kmlPlacemark{
+		Name:        name,
+		Coordinates: formatFloat(s.Longitude) + "," + formatFloat(s.Latitude) + "," + formatFloat(s.Altitude),
+	}
+}
+
+func (k kml) write(out io.Writer) error {
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return errors.WithStack(err)
+	}
+	e := xml.NewEncoder(out)
+	e.Indent("", "  ")
+	if err := e.Encode(k); err != nil {
+		return errors.WithStack(err)
+	}
+	_, err := io.WriteString(out, "\n")
+	return errors.WithStack(err)
+}
+
+// WriteStationsKML writes stations as a KML document of placemarks
+func WriteStationsKML(out io.Writer, stations []Station) error {
+	k := kml{Name: "SYNOP stations"}
+	for _, s := range stations {
+		p := newPlacemark(s, s.Name)
+		p.Data = []kmlData{{"id", s.ID}, {"altitude", strconv.FormatFloat(s.Altitude, 'f', -1, 64)}}
+		k.Placemarks = append(k.Placemarks, p)
+	}
+	return k.write(out)
+}
+
+// WriteMeasuresKML writes measures as a KML document of placemarks at their station, time stamped,
+// with fields as data named after their CSV column. Not reported fields are left out, not observable and invalid ones
+// are empty with their status in a "<column>_status" data.
+// Measures of stations not in stations are left out.
+func WriteMeasuresKML(out io.Writer, measures []Measure, stations []Station) error {
+	byID := joinStations(stations)
+	k := kml{Name: "SYNOP measures"}
+	for _, m := range measures {
+		s, ok := byID[m.StationID]
+		if !ok {
+			continue
+		}
+		p := newPlacemark(s, s.Name)
+		p.When = m.Date.UTC().Format(time.RFC3339)
+		for _, field := range measureFields {
+			if v := field.Value(m); v != nil {
+				p.Data = append(p.Data, kmlData{field.Column, formatValue(v)})
+			} else if status := field.Status(m); status != NotReported {
+				p.Data = append(p.Data, kmlData{field.Column, ""}, kmlData{field.Column + "_status", statusName(status)})
+			}
+		}
+		k.Placemarks = append(k.Placemarks, p)
+	}
+	return k.write(out)
+}
@@ -0,0 +1,115 @@
+package synopcsv
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+var testStations = []Station{
+	{ID: "07005", Name: "ABBEVILLE", Latitude: 50.136, Longitude: 1.834, Altitude: 69},
+}
+
+func TestWriteStationsGeoJSON(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := WriteStationsGeoJSON(buf, testStations); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expected := `{"type":"FeatureCollection","features":[{"type":"Feature","id":"07005","geometry":{"type":"Point","coordinates":[1.834,50.136,69]},"properties":{"altitude":69,"id":"07005","name":"ABBEVILLE"}}]}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Invalid GeoJSON\n%v\nexpected\n%v", buf.String(), expected)
+	}
+}
+
+func TestWriteMeasuresGeoJSON(t *testing.T) {
+	measures, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := WriteMeasuresGeoJSON(buf, measures, testStations); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var fc struct {
+		Features []struct {
+			Geometry struct {
+				Coordinates []float64
+			}
+			Properties map[string]interface{}
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &fc); err != nil {
+		t.Fatal(err)
+	}
+	// 07015 is not in testStations
+	if len(fc.Features) != 1 {
+		t.Fatalf("Invalid number of features %v, expected 1", len(fc.Features))
+	}
+	props := fc.Features[0].Properties
+	if props["numer_sta"] != "07005" || props["name"] != "ABBEVILLE" || props["date"] != "2017-05-01T00:00:00Z" || props["t"] != 283.45 {
+		t.Errorf("Invalid properties %v", props)
+	}
+	if _, ok := props["niv_bar"]; ok {
+		t.Errorf("Not reported niv_bar should be left out: %v", props["niv_bar"])
+	}
+	if _, ok := props["niv_bar_status"]; ok {
+		t.Errorf("Not reported fields should not have a status")
+	}
+	// middle clouds are hidden by lower ones
+	if v, ok := props["cm"]; !ok || v != nil || props["cm_status"] != "not_observable" {
+		t.Errorf("Invalid cm properties: %v, %v", v, props["cm_status"])
+	}
+	if _, ok := props["t_status"]; ok {
+		t.Errorf("Available fields should not have a status")
+	}
+	if c := fc.Features[0].Geometry.Coordinates; len(c) != 3 || c[0] != 1.834 || c[1] != 50.136 {
+		t.Errorf("Invalid coordinates %v", c)
+	}
+}
+
+func TestWriteMeasuresKML(t *testing.T) {
+	measures, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := WriteMeasuresKML(buf, measures, testStations); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var k kml
+	if err := xml.Unmarshal(buf.Bytes(), &k); err != nil {
+		t.Fatalf("Invalid KML: %v\n%v", err, buf.String())
+	}
+	if len(k.Placemarks) != 1 {
+		t.Fatalf("Invalid number of placemarks %v, expected 1", len(k.Placemarks))
+	}
+	p := k.Placemarks[0]
+	if p.Name != "ABBEVILLE" || p.When != "2017-05-01T00:00:00Z" || p.Coordinates != "1.834,50.136,69" {
+		t.Errorf("Invalid placemark %+v", p)
+	}
+	if len(p.Data) == 0 || p.Data[0] != (kmlData{"numer_sta", "07005"}) {
+		t.Errorf("Invalid placemark data %v", p.Data)
+	}
+	data := make(map[string]string)
+	for _, d := range p.Data {
+		data[d.Name] = d.Value
+	}
+	if v, ok := data["cm"]; !ok || v != "" || data["cm_status"] != "not_observable" {
+		t.Errorf("Invalid status data %v", data)
+	}
+	if _, ok := data["niv_bar"]; ok {
+		t.Errorf("Not reported niv_bar should be left out: %v", data)
+	}
+}
+
+func TestWriteStationsKML(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := WriteStationsKML(buf, testStations); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if s := buf.String(); !strings.HasPrefix(s, xml.Header+`<kml xmlns="http://www.opengis.net/kml/2.2">`) || !strings.Contains(s, "<coordinates>1.834,50.136,69</coordinates>") {
+		t.Errorf("Invalid KML\n%v", s)
+	}
+}
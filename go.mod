@@ -1,6 +1,6 @@
 module github.com/jfyuen/synopcsv
 
-go 1.15
+go 1.16
 
 require (
 	github.com/influxdata/influxdb v1.8.3
@@ -0,0 +1,103 @@
+// Package interpolate interpolates station values onto regular grids, in longitude and latitude or in a projected coordinate reference system.
+package interpolate
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/jfyuen/synopcsv/proj"
+	"github.com/pkg/errors"
+)
+
+// NoData is the value written for cells without value in ESRI ASCII grids
+const NoData = -9999
+
+// Grid is a regular grid of square cells, Values[row][col] holding the value at the center of a cell, NaN if none.
+// Row 0 is the northernmost one, as in ESRI ASCII grids.
+type Grid struct {
+	CRS        proj.CRS // nil for longitudes and latitudes in degrees
+	XMin, YMin float64  // lower left corner of the grid
+	CellSize   float64
+	Cols, Rows int
+	Values     [][]float64
+}
+
+// NewGrid returns a grid covering xmin, ymin to xmax, ymax in crs units with cells of cellSize, its values being NaN.
+// crs is nil for a longitude and latitude grid.
+func NewGrid(crs proj.CRS, xmin, ymin, xmax, ymax, cellSize float64) (*Grid, error) {
+	if cellSize <= 0 || xmax <= xmin || ymax <= ymin {
+		return nil, errors.Errorf("invalid grid from (%v, %v) to (%v, %v) with cells of %v", xmin, ymin, xmax, ymax, cellSize)
+	}
+	g := &Grid{
+		CRS:      crs,
+		XMin:     xmin,
+		YMin:     ymin,
+		CellSize: cellSize,
+		Cols:     int(math.Ceil((xmax - xmin) / cellSize)),
+		Rows:     int(math.Ceil((ymax - ymin) / cellSize)),
+	}
+	g.Values = make([][]float64, g.Rows)
+	for r := range g.Values {
+		g.Values[r] = make([]float64, g.Cols)
+		for c := range g.Values[r] {
+			g.Values[r][c] = math.NaN()
+		}
+	}
+	return g, nil
+}
+
+// NewLatLonGrid returns a longitude and latitude grid with cells of cellSize degrees
+func NewLatLonGrid(minLat, minLon, maxLat, maxLon, cellSize float64) (*Grid, error) {
+	return NewGrid(nil, minLon, minLat, maxLon, maxLat, cellSize)
+}
+
+// NewFranceGrid returns a Lambert-93 grid covering metropolitan France and Corsica with cells of cellSize m
+func NewFranceGrid(cellSize float64) (*Grid, error) {
+	return NewGrid(proj.Lambert93, 90000, 6040000, 1250000, 7120000, cellSize)
+}
+
+// Center returns the coordinates of the center of a cell, in grid units
+func (g *Grid) Center(row, col int) (x, y float64) {
+	return g.XMin + (float64(col)+0.5)*g.CellSize, g.YMin + (float64(g.Rows-row)-0.5)*g.CellSize
+}
+
+// LonLat returns the longitude and latitude in degrees of the center of a cell
+func (g *Grid) LonLat(row, col int) (lon, lat float64) {
+	x, y := g.Center(row, col)
+	if g.CRS == nil {
+		return x, y
+	}
+	return g.CRS.Inverse(x, y)
+}
+
+// Cell returns the row and column of the cell holding x, y in grid units, ok being false outside of the grid
+func (g *Grid) Cell(x, y float64) (row, col int, ok bool) {
+	col = int(math.Floor((x - g.XMin) / g.CellSize))
+	row = g.Rows - 1 - int(math.Floor((y-g.YMin)/g.CellSize))
+	return row, col, row >= 0 && row < g.Rows && col >= 0 && col < g.Cols
+}
+
+// WriteASCII writes g as an ESRI ASCII grid, cells without value being written as NoData
+func (g *Grid) WriteASCII(out io.Writer) error {
+	w := bufio.NewWriter(out)
+	formatFloat := func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+	fmt.Fprintf(w, "ncols %d\nnrows %d\nxllcorner %s\nyllcorner %s\ncellsize %s\nNODATA_value %d\n",
+		g.Cols, g.Rows, formatFloat(g.XMin), formatFloat(g.YMin), formatFloat(g.CellSize), NoData)
+	for _, row := range g.Values {
+		for c, v := range row {
+			if c > 0 {
+				w.WriteByte(' ')
+			}
+			if math.IsNaN(v) {
+				w.WriteString(strconv.Itoa(NoData))
+			} else {
+				w.WriteString(strconv.FormatFloat(v, 'f', 3, 64))
+			}
+		}
+		w.WriteByte('\n')
+	}
+	return errors.WithStack(w.Flush())
+}
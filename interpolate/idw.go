@@ -0,0 +1,137 @@
+package interpolate
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jfyuen/synopcsv"
+	"github.com/pkg/errors"
+)
+
+// Sample is the value of a variable at a station
+type Sample struct {
+	Station synopcsv.Station
+	Value   float64
+}
+
+// Samples returns the values of the numeric field read from column in the measures taken at date, joined to their station.
+// Measures of unknown stations or without value are left out.
+func Samples(measures []synopcsv.Measure, stations []synopcsv.Station, column string, date time.Time) ([]Sample, error) {
+	f, ok := synopcsv.MeasureField(column)
+	if !ok {
+		return nil, errors.Errorf("unknown measure column %q", column)
+	}
+	byID := make(map[string]synopcsv.Station, len(stations))
+	for _, s := range stations {
+		byID[s.ID] = s
+	}
+	var samples []Sample
+	for _, m := range measures {
+		s, ok := byID[m.StationID]
+		if !ok || !m.Date.Equal(date) {
+			continue
+		}
+		switch v := f.Value(m).(type) {
+		case nil:
+		case int:
+			samples = append(samples, Sample{s, float64(v)})
+		case float64:
+			samples = append(samples, Sample{s, v})
+		default:
+			return nil, errors.Errorf("measure column %q is not numeric", column)
+		}
+	}
+	return samples, nil
+}
+
+// Options tune the inverse distance weighting
+type Options struct {
+	Power     float64 // exponent of the inverse distance, 2 if 0
+	Neighbors int     // number of nearest samples used for each cell, all if 0
+	Radius    float64 // maximal distance in m of samples used for a cell, unlimited if 0; cells without samples are left NaN
+	// LapseRate is the decrease of the value with altitude, per m, as synopcsv.StandardLapseRate for temperatures.
+	// When not 0, sample values are reduced to sea level before interpolation, and brought back to the altitude
+	// of each cell given by Elevation.
+	LapseRate float64
+	Elevation func(lon, lat float64) float64 // altitude in m of a point, sea level if nil
+}
+
+// sample is a sample with its position
+type sample struct {
+	x, y     float64 // in grid units
+	lon, lat float64
+	value    float64
+}
+
+// neighbor is a sample with its distance to a cell
+type neighbor struct {
+	distance, value float64
+}
+
+// IDW fills the values of g by inverse distance weighting of samples.
+// Distances are great-circle distances for longitude and latitude grids and euclidean distances in the grid CRS otherwise.
+func (g *Grid) IDW(samples []Sample, opts Options) error {
+	if len(samples) == 0 {
+		return errors.New("no sample to interpolate")
+	}
+	power := opts.Power
+	if power == 0 {
+		power = 2
+	}
+	points := make([]sample, len(samples))
+	for i, s := range samples {
+		p := sample{lon: s.Station.Longitude, lat: s.Station.Latitude, value: s.Value + opts.LapseRate*s.Station.Altitude}
+		p.x, p.y = p.lon, p.lat
+		if g.CRS != nil {
+			p.x, p.y = s.Station.Projected(g.CRS)
+		}
+		points[i] = p
+	}
+
+	neighbors := make([]neighbor, 0, len(points))
+	for r := range g.Values {
+		for c := range g.Values[r] {
+			x, y := g.Center(r, c)
+			lon, lat := g.LonLat(r, c)
+			neighbors = neighbors[:0]
+			for _, p := range points {
+				d := math.Hypot(x-p.x, y-p.y)
+				if g.CRS == nil {
+					d = synopcsv.Distance(lat, lon, p.lat, p.lon)
+				}
+				if opts.Radius == 0 || d <= opts.Radius {
+					neighbors = append(neighbors, neighbor{d, p.value})
+				}
+			}
+			if opts.Neighbors > 0 && len(neighbors) > opts.Neighbors {
+				sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].distance < neighbors[j].distance })
+				neighbors = neighbors[:opts.Neighbors]
+			}
+			v := weightedMean(neighbors, power)
+			if opts.LapseRate != 0 && opts.Elevation != nil {
+				v -= opts.LapseRate * opts.Elevation(lon, lat)
+			}
+			g.Values[r][c] = v
+		}
+	}
+	return nil
+}
+
+// weightedMean returns the mean of neighbors weighted by their inverse distance to power,
+// the value of a neighbor at a null distance, or NaN without neighbors
+func weightedMean(neighbors []neighbor, power float64) float64 {
+	var sum, weights float64
+	for _, n := range neighbors {
+		if n.distance == 0 {
+			return n.value
+		}
+		w := 1 / math.Pow(n.distance, power)
+		sum += w * n.value
+		weights += w
+	}
+	if weights == 0 {
+		return math.NaN()
+	}
+	return sum / weights
+}
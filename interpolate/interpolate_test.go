@@ -0,0 +1,116 @@
+package interpolate
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jfyuen/synopcsv"
+	"github.com/jfyuen/synopcsv/synopcsvtest/fixture"
+)
+
+func TestGrid(t *testing.T) {
+	g, err := NewLatLonGrid(42, -5, 51, 8, 0.5)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if g.Cols != 26 || g.Rows != 18 {
+		t.Fatalf("Invalid grid size %vx%v, expected 26x18", g.Cols, g.Rows)
+	}
+	if x, y := g.Center(0, 0); x != -4.75 || y != 50.75 {
+		t.Errorf("Invalid center of the north west cell (%v, %v)", x, y)
+	}
+	if r, c, ok := g.Cell(-4.75, 50.75); !ok || r != 0 || c != 0 {
+		t.Errorf("Invalid cell (%v, %v)", r, c)
+	}
+	if _, _, ok := g.Cell(9, 45); ok {
+		t.Errorf("Point should be outside of the grid")
+	}
+	if _, err := NewLatLonGrid(42, -5, 41, 8, 0.5); err == nil {
+		t.Errorf("Empty grid should fail")
+	}
+}
+
+func TestIDW(t *testing.T) {
+	g, err := NewFranceGrid(10000)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	orly := synopcsv.Station{ID: "07149", Latitude: 48.716833, Longitude: 2.384333, Altitude: 89}
+	lyon := synopcsv.Station{ID: "07481", Latitude: 45.7265, Longitude: 5.077833, Altitude: 235}
+	samples := []Sample{{orly, 280}, {lyon, 290}}
+	if err := g.IDW(samples, Options{}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for r := range g.Values {
+		for c, v := range g.Values[r] {
+			if v < 280 || v > 290 {
+				t.Fatalf("Interpolated value %v at (%v, %v) out of the samples range", v, r, c)
+			}
+		}
+	}
+	r, c, _ := g.Cell(orly.Projected(g.CRS))
+	if v := g.Values[r][c]; math.Abs(v-280) > 0.5 {
+		t.Errorf("Invalid value %v near Orly", v)
+	}
+
+	// at sea level and with a standard lapse rate, values are higher than the samples
+	if err := g.IDW(samples, Options{LapseRate: synopcsv.StandardLapseRate, Radius: 100000}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if v := g.Values[r][c]; math.Abs(v-280.58) > 0.1 {
+		t.Errorf("Invalid sea level value %v near Orly", v)
+	}
+	if v := g.Values[0][0]; !math.IsNaN(v) {
+		t.Errorf("Cell far from samples should have no value, got %v", v)
+	}
+
+	// brought back to the altitude of Orly, values are close to its sample
+	elevation := func(lon, lat float64) float64 { return orly.Altitude }
+	if err := g.IDW(samples, Options{LapseRate: synopcsv.StandardLapseRate, Radius: 100000, Elevation: elevation}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if v := g.Values[r][c]; math.Abs(v-280) > 0.5 {
+		t.Errorf("Invalid value %v at the altitude of Orly", v)
+	}
+}
+
+func TestWriteASCII(t *testing.T) {
+	g, err := NewLatLonGrid(0, 0, 1, 2, 1)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	g.Values[0][0] = 1.5
+	buf := new(bytes.Buffer)
+	if err := g.WriteASCII(buf); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expected := "ncols 2\nnrows 1\nxllcorner 0\nyllcorner 0\ncellsize 1\nNODATA_value -9999\n1.500 -9999\n"
+	if buf.String() != expected {
+		t.Errorf("Invalid ASCII grid\n%v\nexpected\n%v", buf.String(), expected)
+	}
+}
+
+func TestSamples(t *testing.T) {
+	stations := fixture.Stations(t)
+	measures := fixture.Measures(t, "synop.2017050100.csv")
+
+	date := time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC)
+	samples, err := Samples(measures, stations, "t", date)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(samples) == 0 || len(samples) > len(measures) {
+		t.Fatalf("Invalid number of samples %v", len(samples))
+	}
+	if samples, _ := Samples(measures, stations, "t", date.Add(3*time.Hour)); len(samples) != 0 {
+		t.Errorf("No sample expected at another date, got %v", len(samples))
+	}
+	if _, err := Samples(measures, stations, "numer_sta", date); err == nil {
+		t.Errorf("Non numeric column should fail")
+	}
+	if _, err := Samples(measures, stations, "unknown", date); err == nil {
+		t.Errorf("Unknown column should fail")
+	}
+}
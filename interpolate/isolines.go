@@ -0,0 +1,187 @@
+package interpolate
+
+import (
+	"math"
+
+	"github.com/jfyuen/synopcsv/geojson"
+)
+
+// Isoline is a line along which the grid value is Level, through points in grid units
+type Isoline struct {
+	Level  float64
+	Points [][2]float64
+	Closed bool // first and last points are the same
+}
+
+// edge identifies the side between two neighbor cell centers: from (row, col) to its east neighbor, or to its south one if vertical
+type edge struct {
+	row, col int
+	vertical bool
+}
+
+// square edges, between cell centers (r, c), (r, c+1), (r+1, c+1) and (r+1, c)
+const (
+	top = iota
+	right
+	bottom
+	left
+)
+
+// marchingSquares lists the edges joined by segments for each case, corners above the level setting bits
+// 8 for the north west one, 4 north east, 2 south east and 1 south west.
+// Saddles 5 and 10 are listed for a center below the level, and swapped when it is above.
+var marchingSquares = [16][][2]int{
+	1:  {{left, bottom}},
+	2:  {{bottom, right}},
+	3:  {{left, right}},
+	4:  {{top, right}},
+	5:  {{top, right}, {left, bottom}},
+	6:  {{top, bottom}},
+	7:  {{left, top}},
+	8:  {{left, top}},
+	9:  {{top, bottom}},
+	10: {{left, top}, {bottom, right}},
+	11: {{top, right}},
+	12: {{left, right}},
+	13: {{bottom, right}},
+	14: {{left, bottom}},
+}
+
+// Levels returns the multiples of interval between the minimal and maximal values of g, as every 400 Pa for isobars
+func (g *Grid) Levels(interval float64) []float64 {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, row := range g.Values {
+		for _, v := range row {
+			if !math.IsNaN(v) {
+				lo, hi = math.Min(lo, v), math.Max(hi, v)
+			}
+		}
+	}
+	var levels []float64
+	if interval <= 0 || math.IsInf(lo, 0) {
+		return levels
+	}
+	for i := math.Ceil(lo / interval); i*interval <= hi; i++ {
+		levels = append(levels, i*interval)
+	}
+	return levels
+}
+
+// Isolines returns the isolines of g at levels, computed by marching squares between cell centers.
+// Squares with a corner without value are skipped, breaking isolines there.
+func (g *Grid) Isolines(levels []float64) []Isoline {
+	var isolines []Isoline
+	for _, level := range levels {
+		isolines = append(isolines, g.isolines(level)...)
+	}
+	return isolines
+}
+
+// crossing returns the point of e where the value is level, interpolating linearly between its ends
+func (g *Grid) crossing(e edge, level float64) [2]float64 {
+	r2, c2 := e.row, e.col+1
+	if e.vertical {
+		r2, c2 = e.row+1, e.col
+	}
+	x1, y1 := g.Center(e.row, e.col)
+	x2, y2 := g.Center(r2, c2)
+	v1, v2 := g.Values[e.row][e.col], g.Values[r2][c2]
+	t := (level - v1) / (v2 - v1)
+	return [2]float64{x1 + t*(x2-x1), y1 + t*(y2-y1)}
+}
+
+func (g *Grid) isolines(level float64) []Isoline {
+	var segments [][2]edge
+	touching := make(map[edge][]int)
+	for r := 0; r < g.Rows-1; r++ {
+		for c := 0; c < g.Cols-1; c++ {
+			corners := [4]float64{g.Values[r][c], g.Values[r][c+1], g.Values[r+1][c+1], g.Values[r+1][c]}
+			index := 0
+			for _, v := range corners {
+				if math.IsNaN(v) {
+					index = -1
+					break
+				}
+				index <<= 1
+				if v >= level {
+					index |= 1
+				}
+			}
+			if index <= 0 || index == 15 {
+				continue
+			}
+			edges := [4]edge{{r, c, false}, {r, c + 1, true}, {r + 1, c, false}, {r, c, true}}
+			pairs := marchingSquares[index]
+			if (index == 5 || index == 10) && (corners[0]+corners[1]+corners[2]+corners[3])/4 >= level {
+				pairs = marchingSquares[15-index]
+			}
+			for _, p := range pairs {
+				s := [2]edge{edges[p[0]], edges[p[1]]}
+				touching[s[0]] = append(touching[s[0]], len(segments))
+				touching[s[1]] = append(touching[s[1]], len(segments))
+				segments = append(segments, s)
+			}
+		}
+	}
+
+	used := make([]bool, len(segments))
+	// follow walks from the end of segment i opposite to from, until the line ends or closes
+	follow := func(i int, from edge) Isoline {
+		line := Isoline{Level: level, Points: [][2]float64{g.crossing(from, level)}}
+		at := from
+		for {
+			used[i] = true
+			if segments[i][0] == at {
+				at = segments[i][1]
+			} else {
+				at = segments[i][0]
+			}
+			line.Points = append(line.Points, g.crossing(at, level))
+			next := -1
+			for _, j := range touching[at] {
+				if !used[j] {
+					next = j
+				}
+			}
+			if next < 0 {
+				line.Closed = at == from
+				return line
+			}
+			i = next
+		}
+	}
+	var isolines []Isoline
+	// open lines start at an edge of a single segment, remaining segments make closed lines
+	for i, s := range segments {
+		for _, e := range s {
+			if !used[i] && len(touching[e]) == 1 {
+				isolines = append(isolines, follow(i, e))
+			}
+		}
+	}
+	for i, s := range segments {
+		if !used[i] {
+			isolines = append(isolines, follow(i, s[0]))
+		}
+	}
+	return isolines
+}
+
+// IsolinesGeoJSON returns isolines of g as a feature collection of LineStrings in longitude and latitude, with a level property
+func (g *Grid) IsolinesGeoJSON(isolines []Isoline) *geojson.FeatureCollection {
+	fc := geojson.NewFeatureCollection()
+	for _, l := range isolines {
+		positions := make([]geojson.Position, len(l.Points))
+		for i, p := range l.Points {
+			lon, lat := p[0], p[1]
+			if g.CRS != nil {
+				lon, lat = g.CRS.Inverse(p[0], p[1])
+			}
+			positions[i] = geojson.Position{lon, lat}
+		}
+		f := geojson.NewFeature(geojson.NewLineString(positions))
+		f.Properties["level"] = l.Level
+		fc.Add(f)
+	}
+	return fc
+}
@@ -0,0 +1,94 @@
+package interpolate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jfyuen/synopcsv/geojson"
+	"github.com/jfyuen/synopcsv/proj"
+)
+
+// fill sets the values of g to f of the cell centers
+func fill(g *Grid, f func(x, y float64) float64) {
+	for r := range g.Values {
+		for c := range g.Values[r] {
+			g.Values[r][c] = f(g.Center(r, c))
+		}
+	}
+}
+
+func TestIsolinesRamp(t *testing.T) {
+	g, err := NewGrid(nil, 0, 0, 10, 10, 1)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	fill(g, func(x, y float64) float64 { return 100000 + 100*x })
+	levels := g.Levels(200)
+	if len(levels) != 4 || levels[0] != 100200 || levels[3] != 100800 {
+		t.Fatalf("Invalid levels %v", levels)
+	}
+	isolines := g.Isolines([]float64{100250})
+	if len(isolines) != 1 || isolines[0].Closed || len(isolines[0].Points) != 10 {
+		t.Fatalf("Invalid isolines %+v", isolines)
+	}
+	for _, p := range isolines[0].Points {
+		if math.Abs(p[0]-2.5) > 1e-9 {
+			t.Errorf("Invalid isoline point %v, expected x=2.5", p)
+		}
+	}
+}
+
+func TestIsolinesClosed(t *testing.T) {
+	g, err := NewGrid(nil, -10, -10, 10, 10, 0.5)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	fill(g, func(x, y float64) float64 { return math.Hypot(x, y) })
+	isolines := g.Isolines([]float64{5})
+	if len(isolines) != 1 || !isolines[0].Closed {
+		t.Fatalf("Expected a single closed isoline, got %v", len(isolines))
+	}
+	points := isolines[0].Points
+	if points[0] != points[len(points)-1] {
+		t.Errorf("Closed isoline should end at its start")
+	}
+	for _, p := range points {
+		if d := math.Hypot(p[0], p[1]); math.Abs(d-5) > 0.05 {
+			t.Errorf("Isoline point %v is %v away from the center, expected 5", p, d)
+		}
+	}
+
+	// a hole in the grid breaks the circle
+	g.Values[g.Rows/2][g.Cols/2+10] = math.NaN()
+	if isolines := g.Isolines([]float64{5}); len(isolines) != 1 || isolines[0].Closed {
+		t.Errorf("Expected a single open isoline")
+	}
+}
+
+func TestIsolinesSaddle(t *testing.T) {
+	g, err := NewGrid(nil, 0, 0, 2, 2, 1)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	g.Values = [][]float64{{1, 0}, {0, 1}}
+	if isolines := g.Isolines([]float64{0.5}); len(isolines) != 2 {
+		t.Errorf("Expected 2 isolines in a saddle, got %+v", isolines)
+	}
+}
+
+func TestIsolinesGeoJSON(t *testing.T) {
+	g, err := NewFranceGrid(20000)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	fill(g, func(x, y float64) float64 { return 101000 + (y-6600000)/1000 })
+	fc := g.IsolinesGeoJSON(g.Isolines([]float64{101000}))
+	if len(fc.Features) != 1 || fc.Features[0].Properties["level"] != 101000.0 || fc.Features[0].Geometry.Type != "LineString" {
+		t.Fatalf("Invalid isobars %+v", fc.Features)
+	}
+	for _, p := range fc.Features[0].Geometry.Coordinates.([]geojson.Position) {
+		if x, y := proj.Lambert93.Forward(p[0], p[1]); math.Abs(y-6600000) > 1e-3 {
+			t.Errorf("Invalid isobar point (%v, %v)", x, y)
+		}
+	}
+}
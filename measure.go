@@ -1,8 +1,10 @@
 package synopcsv
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -12,69 +14,71 @@ import (
 const na = "mq"
 
 // Measure as defined in https://donneespubliques.meteofrance.fr/client/document/doc_parametres_synop_168.pdf
-// Names are in english for code mapping
+// Names are in english for code mapping, synop tags give the CSV column, the unit and the code table of each field,
+// fields being ordered as CSV columns. Values of unchecked code fields are not validated against their table.
 // Code list is available at https://library.wmo.int/pmb_ged/wmo_306-v1_1-2012_fr.pdf
 type Measure struct {
-	StationID                           string    // numer_sta
-	Date                                time.Time // date
-	SeaPressure                         *int      // pmer, in Pa
-	PressureVariation                   *int      // tend, in Pa
-	BarometricTrend                     *int      // cod_tend, code 0200
-	WindDirection                       *int      // dd, in degrees for 10 min
-	WindSpeed                           *float64  // ff, in m/s for 10 min
-	Temperature                         *float64  // t, in K
-	DewPoint                            *float64  // td, in
-	Humidity                            *int      // u, in %
-	HorizontalVisibility                *float64  // vv, in m
-	PresentTime                         *int      // ww, code 4677
-	PastTime1                           *int      // w1, code 4561
-	PastTime2                           *int      // w2, code 4561
-	TotalNebulosity                     *float64  // n, in %
-	LowerLevelCloudNebulosity           *int      // nbas, in octa
-	LowerLevelCloudHeight               *int      // hbas, in m
-	LowerLevelCloudType                 *int      // cl, code 0513
-	MiddleLevelCloudType                *int      // cm, code 0515
-	HigherLevelCloudType                *int      // ch, code 0509
-	PressureStation                     *int      // pres, in Pa
-	BarometricLevel                     *int      // niv_bar, in Pa
-	Geopotential                        *int      // geop, in m2/s2
-	PressureVariation24Hours            *int      // tend24, in Pa
-	MinimalTemperatureOverLast12Hours   *float64  // tn12, in K
-	MinimalTemperatureOverLast24Hours   *float64  // tn24, in K
-	MaximalTemperatureOverLast12Hours   *float64  // tx12, in K
-	MaximalTemperatureOverLast24Hours   *float64  // tx24, in K
-	MinimalGroundTemperatureOver12Hours *float64  // tminsol, in K
-	TwMeasureMethod                     *int      // sw, code 3855
-	WetBulbTemperature                  *float64  // tw, in K
-	Last10MinutesGust                   *float64  // raf10, in m/s
-	GustOverPeriod                      *float64  // rafper, in m/s
-	GustPeriod                          *float64  // per, min
-	GroundState                         *int      // etat_sol, code 0901
-	SnowHeight                          *float64  // ht_neige, in m
-	FreshSnowHeight                     *float64  // ssfrai, in m
-	FreshSnowPeriod                     *float64  // perssfrai, in 1/10 hour
-	PrecipitationOverLastHour           *float64  // rr1, in mm
-	PrecipitationOverLast3Hours         *float64  // rr3, in mm
-	PrecipitationOverLast6Hours         *float64  // rr6, in mm
-	PrecipitationOverLast12Hours        *float64  // rr12, in mm
-	PrecipitationOverLast24Hours        *float64  // rr24, in mm
-	SpecialPhenomenon1                  *string   // phenspe1, code 3778
-	SpecialPhenomenon2                  *string   // phenspe2, code 3778
-	SpecialPhenomenon3                  *string   // phenspe3, code 3778
-	SpecialPhenomenon4                  *string   // phenspe4, code 3778
-	LevelCloudNebulosity1               *int      // nnuage1, in octa
-	LevelCloudNebulosity2               *int      // nnuage2, in octa
-	LevelCloudNebulosity3               *int      // nnuage3, in octa
-	LevelCloudNebulosity4               *int      // nnuage4, in octa
-	LevelCloudType1                     *int      // ctype1, code 0500
-	LevelCloudType2                     *int      // ctype2, code 0500
-	LevelCloudType3                     *int      // ctype3, code 0500
-	LevelCloudType4                     *int      // ctype4, code 0500
-	LevelBaseHeight1                    *int      // hnuage1, in m
-	LevelBaseHeight2                    *int      // hnuage2, in m
-	LevelBaseHeight3                    *int      // hnuage3, in m
-	LevelBaseHeight4                    *int      // hnuage4, in m
+	StationID                           string             `synop:"numer_sta"`
+	Date                                time.Time          `synop:"date"`
+	SeaPressure                         *int               `synop:"pmer,unit=Pa"`
+	PressureVariation                   *int               `synop:"tend,unit=Pa"`
+	BarometricTrend                     *int               `synop:"cod_tend,code=0200"`
+	WindDirection                       *int               `synop:"dd,unit=degree"` // for 10 min
+	WindSpeed                           *float64           `synop:"ff,unit=m/s"`    // for 10 min
+	Temperature                         *float64           `synop:"t,unit=K"`
+	DewPoint                            *float64           `synop:"td,unit=K"`
+	Humidity                            *int               `synop:"u,unit=%"`
+	HorizontalVisibility                *float64           `synop:"vv,unit=m"`
+	PresentTime                         *int               `synop:"ww,code=4677,unchecked"`
+	PastTime1                           *int               `synop:"w1,code=4561,unchecked"`
+	PastTime2                           *int               `synop:"w2,code=4561,unchecked"`
+	TotalNebulosity                     *float64           `synop:"n,unit=%"`
+	LowerLevelCloudNebulosity           *int               `synop:"nbas,unit=octa"`
+	LowerLevelCloudHeight               *int               `synop:"hbas,unit=m"`
+	LowerLevelCloudType                 *int               `synop:"cl,code=0513"`
+	MiddleLevelCloudType                *int               `synop:"cm,code=0515"`
+	HigherLevelCloudType                *int               `synop:"ch,code=0509"`
+	PressureStation                     *int               `synop:"pres,unit=Pa"`
+	BarometricLevel                     *int               `synop:"niv_bar,unit=Pa"`
+	Geopotential                        *int               `synop:"geop,unit=m2/s2"`
+	PressureVariation24Hours            *int               `synop:"tend24,unit=Pa"`
+	MinimalTemperatureOverLast12Hours   *float64           `synop:"tn12,unit=K"`
+	MinimalTemperatureOverLast24Hours   *float64           `synop:"tn24,unit=K"`
+	MaximalTemperatureOverLast12Hours   *float64           `synop:"tx12,unit=K"`
+	MaximalTemperatureOverLast24Hours   *float64           `synop:"tx24,unit=K"`
+	MinimalGroundTemperatureOver12Hours *float64           `synop:"tminsol,unit=K"`
+	TwMeasureMethod                     *int               `synop:"sw,code=3855,unchecked"`
+	WetBulbTemperature                  *float64           `synop:"tw,unit=K"`
+	Last10MinutesGust                   *float64           `synop:"raf10,unit=m/s"`
+	GustOverPeriod                      *float64           `synop:"rafper,unit=m/s"`
+	GustPeriod                          *float64           `synop:"per,unit=min"`
+	GroundState                         *int               `synop:"etat_sol,code=0901"`
+	SnowHeight                          *float64           `synop:"ht_neige,unit=m"`
+	FreshSnowHeight                     *float64           `synop:"ssfrai,unit=m"`
+	FreshSnowPeriod                     *float64           `synop:"perssfrai,unit=1/10 hour"`
+	PrecipitationOverLastHour           *float64           `synop:"rr1,unit=mm"`
+	PrecipitationOverLast3Hours         *float64           `synop:"rr3,unit=mm"`
+	PrecipitationOverLast6Hours         *float64           `synop:"rr6,unit=mm"`
+	PrecipitationOverLast12Hours        *float64           `synop:"rr12,unit=mm"`
+	PrecipitationOverLast24Hours        *float64           `synop:"rr24,unit=mm"`
+	SpecialPhenomenon1                  *SpecialPhenomenon `synop:"phenspe1,code=3778"`
+	SpecialPhenomenon2                  *SpecialPhenomenon `synop:"phenspe2,code=3778"`
+	SpecialPhenomenon3                  *SpecialPhenomenon `synop:"phenspe3,code=3778"`
+	SpecialPhenomenon4                  *SpecialPhenomenon `synop:"phenspe4,code=3778"`
+	LevelCloudNebulosity1               *int               `synop:"nnuage1,unit=octa"`
+	LevelCloudType1                     *int               `synop:"ctype1,code=0500"`
+	LevelBaseHeight1                    *int               `synop:"hnuage1,unit=m"`
+	LevelCloudNebulosity2               *int               `synop:"nnuage2,unit=octa"`
+	LevelCloudType2                     *int               `synop:"ctype2,code=0500"`
+	LevelBaseHeight2                    *int               `synop:"hnuage2,unit=m"`
+	LevelCloudNebulosity3               *int               `synop:"nnuage3,unit=octa"`
+	LevelCloudType3                     *int               `synop:"ctype3,code=0500"`
+	LevelBaseHeight3                    *int               `synop:"hnuage3,unit=m"`
+	LevelCloudNebulosity4               *int               `synop:"nnuage4,unit=octa"`
+	LevelCloudType4                     *int               `synop:"ctype4,code=0500"`
+	LevelBaseHeight4                    *int               `synop:"hnuage4,unit=m"`
 
+	status statusBits // why fields are not available
 }
 
 // FetchMeasureCSV retrieve past measures in CSV format
@@ -83,21 +87,15 @@ type Measure struct {
 // or https://donneespubliques.meteofrance.fr/donnees_libres/Txt/Synop/synop.${DATE}.csv
 // with ${DATE} a string as YYYYMMDDHH
 func FetchMeasureCSV(date string) (io.Reader, error) {
-	baseURL := "https://donneespubliques.meteofrance.fr/donnees_libres/Txt/Synop/"
-	url := ""
-	switch len(date) {
-	case 6:
-		url = baseURL + fmt.Sprintf("Archive/synop.%v.csv.gz", date)
-	case 10:
-		url = baseURL + fmt.Sprintf("synop.%v.csv", date)
-	default:
-		return nil, errors.Errorf("wrong date size: %v", date)
-	}
-	return fetchURL(url)
+	return DefaultFetcher.FetchMeasureCSV(context.Background(), date)
 }
 
 // valid code description are available at http://www.meteo.fr/meteonet/DIR_reso40/fichiers_obs_france_web_reso40_f.htm
+// a nil value is returned for codes telling the value is not observable
 func isCodeValid(v int, code string) (*int, bool) {
+	if n, ok := notObservableCodes[code]; ok && v == n {
+		return nil, true
+	}
 	switch code {
 	case "0200": // cod_tend
 		return &v, v >= 0 && v <= 10
@@ -106,171 +104,289 @@ func isCodeValid(v int, code string) (*int, bool) {
 	case "4561": // w1, w2
 		return &v, v >= 0 && v <= 9
 	case "0513": // cl: BUFR table 020012
-		if v == 62 {
-			return nil, true
-		}
 		return &v, v >= 30 && v <= 39
 	case "0515": // cm: BUFR table 020012
-		if v == 61 {
-			return nil, true
-		}
 		return &v, v >= 20 && v <= 29
 	case "0509": // ch: BUFR table 020012
-		if v == 60 {
-			return nil, true
-		}
 		return &v, v >= 10 && v <= 19
 	case "3855": // sw
 		return &v, v >= 0 && v <= 7
 	case "0901": // etat_sol
 		return &v, v >= 0 && v <= 19
-	case "3778": // phenspheN: Do not know how to check
-		return &v, true
 	case "0500": // ctypeN
-		if v == 59 {
-			return nil, true
-		}
 		return &v, v >= 0 && v <= 9
 	default:
 		return nil, false
 	}
 }
 
+// ParseError describes a value that could not be parsed from a CSV file
+type ParseError struct {
+	Line     int       // line number in the CSV file, the header being line 1
+	Column   string    // CSV column name
+	Value    string    // raw value
+	Station  string    // station ID of the row
+	Date     time.Time // date of the row, zero if it could not be parsed
+	Expected string    // expected type, or code table as "code 0513"
+	Err      error
+}
+
+func (e *ParseError) Error() string {
+	at := ""
+	if !e.Date.IsZero() {
+		at = " at " + e.Date.Format(time.RFC3339)
+	}
+	return fmt.Sprintf("line %v, station %v%v: invalid value %q for %v, expected %v: %v", e.Line, e.Station, at, e.Value, e.Column, e.Expected, e.Err)
+}
+
+// Unwrap returns the underlying parsing error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
 type parser struct {
-	err error
+	row      CSVRow
+	line     int
+	station  string
+	date     time.Time
+	lenient  bool
+	skip     bool
+	err      error
+	problems []*ParseError
+	status   statusBits
 }
 
-func (p *parser) parseFloat(s string) *float64 {
-	if p.err != nil || s == na {
+func (p *parser) setStatus(column string, s Status) {
+	p.status.set(columnIndex[column], s)
+}
+
+// value returns the raw value of column, and false when it should not be parsed
+func (p *parser) value(column string) (string, bool) {
+	s := p.row[column]
+	if p.err != nil {
+		return s, false
+	}
+	if s == na {
+		p.setStatus(column, NotReported)
+		return s, false
+	}
+	return s, true
+}
+
+// fail stops parsing on the first error, or records it and goes on in lenient mode
+func (p *parser) fail(column string, expected string, err error) {
+	e := &ParseError{
+		Line:     p.line,
+		Column:   column,
+		Value:    p.row[column],
+		Station:  p.station,
+		Date:     p.date,
+		Expected: expected,
+		Err:      err,
+	}
+	if p.lenient {
+		p.problems = append(p.problems, e)
+		p.setStatus(column, Invalid)
+		return
+	}
+	p.err = errors.WithStack(e)
+}
+
+func (p *parser) parseFloat(column string) *float64 {
+	s, ok := p.value(column)
+	if !ok {
 		return nil
 	}
 	val, err := strconv.ParseFloat(s, 64)
-	p.err = errors.WithStack(err)
+	if err != nil {
+		p.fail(column, "float", err)
+		return nil
+	}
 	return &val
 }
 
-func (p *parser) parseInt(s string) *int {
-	if p.err != nil || s == na {
+func (p *parser) parseInt(column string) *int {
+	s, ok := p.value(column)
+	if !ok {
 		return nil
 	}
 	val, err := strconv.Atoi(s)
-	p.err = errors.WithStack(err)
+	if err != nil {
+		p.fail(column, "int", err)
+		return nil
+	}
 	return &val
 }
 
-func (p *parser) parseCode(s string, code string) *int {
-	if p.err != nil || s == na {
+func (p *parser) parseCode(column string, code string) *int {
+	s, ok := p.value(column)
+	if !ok {
 		return nil
 	}
 	val, err := strconv.Atoi(s)
 	if err != nil {
-		p.err = errors.Wrapf(err, "error reading %v for code %v", s, code)
+		p.fail(column, "code "+code, err)
 		return nil
 	}
 	r, ok := isCodeValid(val, code)
 	if !ok {
-		p.err = errors.Errorf("Invalid code: %v for %v", val, code)
+		p.fail(column, "code "+code, errors.Errorf("value %v not in code table", val))
 		return nil
 	}
+	if r == nil {
+		p.setStatus(column, NotObservable)
+	}
 	return r
 }
 
-func (p *parser) parseDate(s string) time.Time {
+// parseDate parses the measure date, a row without a valid date is skipped in lenient mode
+func (p *parser) parseDate(column string) time.Time {
 	var t time.Time
 	if p.err != nil {
 		return t
 	}
-	t, err := time.Parse("20060102150405", s)
+	t, err := time.Parse("20060102150405", p.row[column])
 	if err != nil {
-		p.err = errors.WithStack(err)
+		p.skip = true
+		p.fail(column, "date as YYYYMMDDHHMMSS", err)
 	}
+	p.date = t
 	return t
 }
 
-func (p *parser) parseString(s string) *string {
-	if p.err != nil || s == na {
+func (p *parser) parseString(column string) *string {
+	s, ok := p.value(column)
+	if !ok {
 		return nil
 	}
 	return &s
 }
 
-// ParseMeasureCSV parses measures from a CSV file formated as https://donneespubliques.meteofrance.fr/client/document/doc_parametres_synop_168.pdf
-func ParseMeasureCSV(in io.Reader) ([]Measure, error) {
-	csvVals, err := parseCSV(in)
+func (p *parser) parseSpecialPhenomenon(column string) *SpecialPhenomenon {
+	s, ok := p.value(column)
+	if !ok {
+		return nil
+	}
+	val, err := ParseSpecialPhenomenon(s)
 	if err != nil {
-		return nil, err
+		p.fail(column, "code 3778", err)
+		return nil
 	}
+	return &val
+}
 
-	measures := make([]Measure, 0)
-	for _, row := range csvVals.rows {
-		measure := Measure{StationID: row["numer_sta"]}
+func (p *parser) parseMeasure() Measure {
+	measure := Measure{StationID: p.row["numer_sta"]}
+	p.station = measure.StationID
+	measure.Date = p.parseDate("date")
 
-		p := parser{}
-		measure.Date = p.parseDate(row["date"])
-		measure.SeaPressure = p.parseInt(row["pmer"])
-		measure.PressureVariation = p.parseInt(row["tend"])
-		measure.BarometricTrend = p.parseCode(row["cod_tend"], "0200")
-		measure.WindDirection = p.parseInt(row["dd"])
-		measure.WindSpeed = p.parseFloat(row["ff"])
-		measure.Temperature = p.parseFloat(row["t"])
-		measure.DewPoint = p.parseFloat(row["td"])
-		measure.Humidity = p.parseInt(row["u"])
-		measure.HorizontalVisibility = p.parseFloat(row["vv"])
-		measure.PresentTime = p.parseInt(row["ww"])
-		measure.PastTime1 = p.parseInt(row["w1"])
-		measure.PastTime2 = p.parseInt(row["w2"])
-		measure.TotalNebulosity = p.parseFloat(row["n"])
-		measure.LowerLevelCloudNebulosity = p.parseInt(row["nbas"])
-		measure.LowerLevelCloudHeight = p.parseInt(row["hbas"])
-		measure.LowerLevelCloudType = p.parseCode(row["cl"], "0513")
-		measure.MiddleLevelCloudType = p.parseCode(row["cm"], "0515")
-		measure.HigherLevelCloudType = p.parseCode(row["ch"], "0509")
-		measure.PressureStation = p.parseInt(row["pres"])
-		measure.BarometricLevel = p.parseInt(row["niv_bar"])
-		measure.Geopotential = p.parseInt(row["geop"])
-		measure.PressureVariation24Hours = p.parseInt(row["tend24"])
-		measure.MinimalTemperatureOverLast12Hours = p.parseFloat(row["tn12"])
-		measure.MinimalTemperatureOverLast24Hours = p.parseFloat(row["tn24"])
-		measure.MaximalTemperatureOverLast12Hours = p.parseFloat(row["tx12"])
-		measure.MaximalTemperatureOverLast24Hours = p.parseFloat(row["tx24"])
+	v := reflect.ValueOf(&measure).Elem()
+	for _, f := range measureFields {
+		field := v.Field(f.index)
+		switch field.Type() {
+		case intPtrType:
+			if f.checked {
+				field.Set(reflect.ValueOf(p.parseCode(f.Column, f.Code)))
+			} else {
+				field.Set(reflect.ValueOf(p.parseInt(f.Column)))
+			}
+		case floatPtrType:
+			field.Set(reflect.ValueOf(p.parseFloat(f.Column)))
+		case stringPtrType:
+			field.Set(reflect.ValueOf(p.parseString(f.Column)))
+		case phenomenonPtrType:
+			field.Set(reflect.ValueOf(p.parseSpecialPhenomenon(f.Column)))
+		}
+	}
+	measure.status = p.status
+	return measure
+}
 
-		measure.MinimalGroundTemperatureOver12Hours = p.parseFloat(row["tminsol"])
-		measure.TwMeasureMethod = p.parseInt(row["sw"])
-		measure.WetBulbTemperature = p.parseFloat(row["tw"])
-		measure.Last10MinutesGust = p.parseFloat(row["raf10"])
-		measure.GustOverPeriod = p.parseFloat(row["rafper"])
-		measure.GustPeriod = p.parseFloat(row["per"])
-		measure.GroundState = p.parseCode(row["etat_sol"], "0901")
-		measure.SnowHeight = p.parseFloat(row["ht_neige"])
-		measure.FreshSnowHeight = p.parseFloat(row["ssfrai"])
-		measure.FreshSnowPeriod = p.parseFloat(row["perssfrai"])
-		measure.PrecipitationOverLastHour = p.parseFloat(row["rr1"])
-		measure.PrecipitationOverLast3Hours = p.parseFloat(row["rr3"])
-		measure.PrecipitationOverLast6Hours = p.parseFloat(row["rr6"])
-		measure.PrecipitationOverLast12Hours = p.parseFloat(row["rr12"])
-		measure.PrecipitationOverLast24Hours = p.parseFloat(row["rr24"])
-		measure.SpecialPhenomenon1 = p.parseString(row["phenspe1"])
-		measure.SpecialPhenomenon2 = p.parseString(row["phenspe2"])
-		measure.SpecialPhenomenon3 = p.parseString(row["phenspe3"])
-		measure.SpecialPhenomenon4 = p.parseString(row["phenspe4"])
-		measure.LevelCloudNebulosity1 = p.parseInt(row["nnuage1"])
-		measure.LevelCloudNebulosity2 = p.parseInt(row["nnuage2"])
-		measure.LevelCloudNebulosity3 = p.parseInt(row["nnuage3"])
-		measure.LevelCloudNebulosity4 = p.parseInt(row["nnuage4"])
-		measure.LevelCloudType1 = p.parseCode(row["ctype1"], "0500")
-		measure.LevelCloudType2 = p.parseCode(row["ctype2"], "0500")
-		measure.LevelCloudType3 = p.parseCode(row["ctype3"], "0500")
-		measure.LevelCloudType4 = p.parseCode(row["ctype4"], "0500")
-		measure.LevelBaseHeight1 = p.parseInt(row["hnuage1"])
-		measure.LevelBaseHeight2 = p.parseInt(row["hnuage2"])
-		measure.LevelBaseHeight3 = p.parseInt(row["hnuage3"])
-		measure.LevelBaseHeight4 = p.parseInt(row["hnuage4"])
+// MeasureReader reads measures one row at a time from a CSV file formated as https://donneespubliques.meteofrance.fr/client/document/doc_parametres_synop_168.pdf
+// Memory usage does not depend on the input size.
+type MeasureReader struct {
+	// Lenient sets invalid values to nil instead of failing, each of them being reported by Problems.
+	// Rows with an invalid date are skipped.
+	Lenient bool
+
+	csv      *csvReader
+	measure  Measure
+	problems []*ParseError
+	err      error
+}
 
-		if p.err != nil {
-			return nil, p.err
+// NewMeasureReader returns a MeasureReader reading from in
+func NewMeasureReader(in io.Reader) *MeasureReader {
+	return &MeasureReader{csv: newCSVReader(in)}
+}
+
+// Next parses the next measure, which is then available through Measure.
+// It returns false at the end of the input or on the first error, to be checked with Err.
+func (r *MeasureReader) Next() bool {
+	r.problems = nil
+	for r.err == nil {
+		row, err := r.csv.next()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			r.err = err
+			return false
+		}
+		p := parser{row: row, line: r.csv.line, lenient: r.Lenient}
+		r.measure = p.parseMeasure()
+		r.err = p.err
+		r.problems = append(r.problems, p.problems...)
+		if !p.skip {
+			return r.err == nil
 		}
-		measures = append(measures, measure)
 	}
+	return false
+}
+
+// Measure returns the last measure parsed by Next
+func (r *MeasureReader) Measure() Measure {
+	return r.measure
+}
+
+// Problems returns the invalid values found in lenient mode by the last call to Next,
+// including the ones from skipped rows
+func (r *MeasureReader) Problems() []*ParseError {
+	return r.problems
+}
 
+// Err returns the first error encountered while reading, if any
+func (r *MeasureReader) Err() error {
+	return r.err
+}
+
+// ParseMeasureCSV parses measures from a CSV file formated as https://donneespubliques.meteofrance.fr/client/document/doc_parametres_synop_168.pdf
+func ParseMeasureCSV(in io.Reader) ([]Measure, error) {
+	r := NewMeasureReader(in)
+	measures := make([]Measure, 0)
+	for r.Next() {
+		measures = append(measures, r.Measure())
+	}
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
 	return measures, nil
 }
+
+// ParseMeasureCSVLenient parses measures like ParseMeasureCSV, but sets invalid values to nil
+// and skips rows without a valid date, returning the list of problems found instead of failing
+func ParseMeasureCSVLenient(in io.Reader) ([]Measure, []*ParseError, error) {
+	r := NewMeasureReader(in)
+	r.Lenient = true
+	measures := make([]Measure, 0)
+	problems := make([]*ParseError, 0)
+	for r.Next() {
+		measures = append(measures, r.Measure())
+		problems = append(problems, r.Problems()...)
+	}
+	problems = append(problems, r.Problems()...)
+	if err := r.Err(); err != nil {
+		return nil, nil, err
+	}
+	return measures, problems, nil
+}
@@ -1,15 +1,22 @@
 package synopcsv
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/jfyuen/synopcsv/synopcsvtest"
 )
 
 func TestFetchMeasureCSVDayTime(t *testing.T) {
-	today := time.Now()
-	date := today.Format("20060102") + "00" // Load today at midnight for test data
-	r, err := FetchMeasureCSV(date)
+	server := synopcsvtest.NewFixtureServer()
+	defer server.Close()
+
+	f := &Fetcher{BaseURL: server.BaseURL}
+	r, err := f.FetchMeasureCSV(context.Background(), "2017050100")
 	if err != nil {
 		t.Fatal(fmt.Printf("%+v\n", err))
 	}
@@ -17,15 +24,18 @@ func TestFetchMeasureCSVDayTime(t *testing.T) {
 	if err != nil {
 		t.Fatal(fmt.Printf("%+v\n", err))
 	}
-	// Measures count usually go from 46 to 62, usually more around 60
-	expectedMin, expectedMax := 46, 62
-	if expectedMin > len(measures) || len(measures) > expectedMax {
-		t.Fatalf("Invalid number of measures: found %v, expected between %v and %v", len(measures), expectedMin, expectedMax)
+	expected := 57
+	if len(measures) != expected {
+		t.Fatalf("Invalid number of measures: found %v, expected %v", len(measures), expected)
 	}
 }
 
 func TestFetchMeasureCSVMonth(t *testing.T) {
-	r, err := FetchMeasureCSV("201705")
+	server := synopcsvtest.NewFixtureServer()
+	defer server.Close()
+
+	f := &Fetcher{BaseURL: server.BaseURL}
+	r, err := f.FetchMeasureCSV(context.Background(), "201705")
 	if err != nil {
 		t.Fatal(fmt.Printf("%+v\n", err))
 	}
@@ -33,8 +43,96 @@ func TestFetchMeasureCSVMonth(t *testing.T) {
 	if err != nil {
 		t.Fatal(fmt.Printf("%+v\n", err))
 	}
-	expected := 14209
+	expected := 931
 	if len(measures) != expected {
 		t.Fatalf("Invalid number of measures: found %v, expected %v", len(measures), expected)
 	}
 }
+
+const testMeasureCSV = `numer_sta;date;pmer;tend;cod_tend;dd;ff;t;td;u;vv;ww;w1;w2;n;nbas;hbas;cl;cm;ch;pres;niv_bar;geop;tend24;tn12;tn24;tx12;tx24;tminsol;sw;tw;raf10;rafper;per;etat_sol;ht_neige;ssfrai;perssfrai;rr1;rr3;rr6;rr12;rr24;phenspe1;phenspe2;phenspe3;phenspe4;nnuage1;ctype1;hnuage1;nnuage2;ctype2;hnuage2;nnuage3;ctype3;hnuage3;nnuage4;ctype4;hnuage4;
+07005;20170501000000;101650;-30;8;250;2.100000;283.450000;281.650000;88;20000;2;0;0;100;8;450;35;61;60;101040;mq;mq;-30;mq;mq;mq;mq;mq;mq;mq;3.600000;3.600000;-10;mq;mq;mq;mq;mq;0.000000;mq;mq;mq;mq;mq;mq;mq;8;6;450;mq;mq;mq;mq;mq;mq;mq;mq;mq;
+07015;20170501000000;101620;-20;6;230;3.600000;284.150000;282.050000;87;15000;61;6;2;100;8;300;35;61;60;100890;mq;mq;10;mq;mq;mq;mq;mq;mq;mq;5.100000;5.100000;-10;mq;mq;mq;mq;mq;1.200000;mq;mq;mq;mq;mq;mq;mq;7;6;300;8;6;900;mq;mq;mq;mq;mq;mq;
+`
+
+func TestMeasureReader(t *testing.T) {
+	r := NewMeasureReader(strings.NewReader(testMeasureCSV))
+	ids := make([]string, 0)
+	for r.Next() {
+		ids = append(ids, r.Measure().StationID)
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(ids) != 2 || ids[0] != "07005" || ids[1] != "07015" {
+		t.Fatalf("Invalid stations read: %v", ids)
+	}
+
+	measures, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	m := measures[1]
+	if *m.SeaPressure != 101620 || *m.Temperature != 284.15 || m.MiddleLevelCloudType != nil || *m.LevelBaseHeight2 != 900 {
+		t.Errorf("Invalid measure parsed: %+v", m)
+	}
+	if m.Date != time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Invalid date parsed: %v", m.Date)
+	}
+}
+
+func TestMeasureReaderError(t *testing.T) {
+	in := strings.Replace(testMeasureCSV, "07015;20170501000000;101620", "07015;20170501000000;", 1)
+	r := NewMeasureReader(strings.NewReader(in))
+	count := 0
+	for r.Next() {
+		count++
+	}
+	if r.Err() == nil || count != 1 {
+		t.Fatalf("expected an error after the first measure, got %v after %v measures", r.Err(), count)
+	}
+	var parseErr *ParseError
+	if !errors.As(r.Err(), &parseErr) {
+		t.Fatalf("expected a ParseError, got %v", r.Err())
+	}
+	date := time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC)
+	if parseErr.Line != 3 || parseErr.Column != "pmer" || parseErr.Station != "07015" || parseErr.Date != date || parseErr.Expected != "int" {
+		t.Errorf("Invalid error context: %+v", parseErr)
+	}
+}
+
+func TestParseMeasureCSVLenient(t *testing.T) {
+	in := strings.Replace(testMeasureCSV, "07015;20170501000000;101620;-20;6", "07015;20170501000000;;-20;42", 1)
+	in += "07020;2017050100;101620;-20;6;230;3.600000;284.150000;282.050000;87;15000;61;6;2;100;8;300;35;61;60;100890;mq;mq;10;mq;mq;mq;mq;mq;mq;mq;5.100000;5.100000;-10;mq;mq;mq;mq;mq;1.200000;mq;mq;mq;mq;mq;mq;mq;7;6;300;8;6;900;mq;mq;mq;mq;mq;mq;\n"
+	if _, err := ParseMeasureCSV(strings.NewReader(in)); err == nil {
+		t.Fatal("expected an error in strict mode")
+	}
+
+	measures, problems, err := ParseMeasureCSVLenient(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(measures) != 2 {
+		t.Fatalf("Invalid number of measures: found %v, expected 2", len(measures))
+	}
+	m := measures[1]
+	if m.SeaPressure != nil || m.BarometricTrend != nil || *m.PressureVariation != -20 {
+		t.Errorf("invalid values should be nil: %+v", m)
+	}
+	if m.Status("pmer") != Invalid || m.Status("cod_tend") != Invalid {
+		t.Errorf("invalid values should have an Invalid status: %v %v", m.Status("pmer"), m.Status("cod_tend"))
+	}
+	expected := []struct {
+		line   int
+		column string
+		value  string
+	}{{3, "pmer", ""}, {3, "cod_tend", "42"}, {4, "date", "2017050100"}}
+	if len(problems) != len(expected) {
+		t.Fatalf("Invalid problems: %v", problems)
+	}
+	for i, e := range expected {
+		p := problems[i]
+		if p.Line != e.line || p.Column != e.column || p.Value != e.value {
+			t.Errorf("Invalid problem: %v, expected %+v", p, e)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package synopcsv
+
+import (
+	"strconv"
+
+	"github.com/jfyuen/synopcsv/codes"
+	"github.com/pkg/errors"
+)
+
+// SpecialPhenomenon is a special phenomenon group 9SpSpspsp, as found in phenspe columns
+type SpecialPhenomenon struct {
+	Group string   // group as found in the CSV file
+	Kind  int      // SpSp, kind of phenomenon from code table 3778
+	Code  int      // spsp, coded value of the phenomenon
+	Value *float64 // decoded value in Unit, nil when not measured or for kinds without a decoded value
+	Unit  string
+}
+
+// ParseSpecialPhenomenon parses a 9SpSpspsp group, the leading 9 being optional.
+// Only the kinds listed in codes.SpecialPhenomena are accepted, other groups of code table 3778 are rejected.
+// Temperature extremes are not decoded here: they are reported in the 1snTxTxTx and 2snTnTnTn groups
+// of section 3, found in the tx12, tn12, tx24 and tn24 columns.
+func ParseSpecialPhenomenon(group string) (SpecialPhenomenon, error) {
+	p := SpecialPhenomenon{Group: group}
+	digits := group
+	if len(digits) == 5 && digits[0] == '9' {
+		digits = digits[1:]
+	}
+	if len(digits) != 4 {
+		return p, errors.Errorf("invalid group length for %q, expected 9SpSpspsp", group)
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return p, errors.Errorf("invalid character %q in group %q", c, group)
+		}
+	}
+	p.Kind, _ = strconv.Atoi(digits[:2])
+	p.Code, _ = strconv.Atoi(digits[2:])
+	if _, ok := codes.SpecialPhenomena.Lookup(p.Kind); !ok {
+		return p, errors.Errorf("unsupported kind %02d of code table 3778 in group %q", p.Kind, group)
+	}
+
+	var err error
+	switch p.Kind {
+	case 10, 11, 12, 13, 14: // ff
+		p.Unit = "m/s"
+		p.Value = floatPtr(float64(p.Code))
+	case 15: // dd, code table 0877
+		p.Unit = "degree"
+		switch {
+		case p.Code <= 36:
+			p.Value = floatPtr(float64(p.Code * 10))
+		case p.Code != 99: // 99 is variable direction
+			err = errors.Errorf("invalid wind direction %v in group %q", p.Code, group)
+		}
+	case 30, 32, 34, 35, 36, 37: // RR, code table 3570
+		p.Unit = "mm"
+		p.Value = decodeAmount(p.Code)
+	case 31: // ss, code table 3870, in cm
+		p.Unit = "m"
+		if v := decodeAmount(p.Code); v != nil {
+			p.Value = floatPtr(*v / 100)
+		}
+	}
+	return p, err
+}
+
+// decodeAmount decodes code tables 3570 and 3870, returning nil for traces and impossible measurements
+func decodeAmount(code int) *float64 {
+	switch {
+	case code <= 55:
+		return floatPtr(float64(code))
+	case code <= 90:
+		return floatPtr(float64((code - 50) * 10))
+	case code <= 96:
+		return floatPtr(float64(code-90) / 10)
+	default: // 97 is trace, 98 more than 400, 99 measurement impossible
+		return nil
+	}
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+// String returns the group as found in the CSV file
+func (p SpecialPhenomenon) String() string {
+	return p.Group
+}
+
+// Text describes the kind of phenomenon, or returns an empty string for undescribed kinds
+func (p SpecialPhenomenon) Text(lang codes.Language) string {
+	return codes.SpecialPhenomena.Text(p.Kind, lang)
+}
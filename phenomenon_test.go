@@ -0,0 +1,61 @@
+package synopcsv
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jfyuen/synopcsv/codes"
+)
+
+func TestParseSpecialPhenomenon(t *testing.T) {
+	tests := []struct {
+		group string
+		kind  int
+		value float64
+		unit  string
+	}{
+		{"91025", 10, 25, "m/s"},
+		{"1527", 15, 270, "degree"},
+		{"93212", 32, 12, "mm"},
+		{"93260", 32, 100, "mm"},
+		{"93192", 31, 0.002, "m"},
+	}
+	for _, test := range tests {
+		p, err := ParseSpecialPhenomenon(test.group)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if p.Kind != test.kind || p.Value == nil || *p.Value != test.value || p.Unit != test.unit || p.String() != test.group {
+			t.Errorf("Invalid phenomenon for %v: %+v", test.group, p)
+		}
+	}
+
+	if p, err := ParseSpecialPhenomenon("91599"); err != nil || p.Value != nil {
+		t.Errorf("variable wind direction should have no value: %+v %v", p, err)
+	}
+	for _, group := range []string{"910", "81025", "9a025", "91540", "93310", "90012"} {
+		if _, err := ParseSpecialPhenomenon(group); err == nil {
+			t.Errorf("expected an error for %v", group)
+		}
+	}
+}
+
+func TestParseMeasureSpecialPhenomenon(t *testing.T) {
+	in := strings.Replace(testMeasureCSV, "1.200000;mq;mq;mq;mq;mq;mq;mq;7", "1.200000;mq;mq;mq;93205;mq;mq;mq;7", 1)
+	measures, err := ParseMeasureCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	p := measures[1].SpecialPhenomenon1
+	if p == nil || p.Kind != 32 || *p.Value != 5 || p.Text(codes.English) != "Maximum diameter of hailstones" {
+		t.Errorf("Invalid special phenomenon: %+v", p)
+	}
+
+	in = strings.Replace(testMeasureCSV, "1.200000;mq;mq;mq;mq;mq;mq;mq;7", "1.200000;mq;mq;mq;9x205;mq;mq;mq;7", 1)
+	_, err = ParseMeasureCSV(strings.NewReader(in))
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Column != "phenspe1" || parseErr.Expected != "code 3778" {
+		t.Errorf("expected a ParseError on phenspe1, got %v", err)
+	}
+}
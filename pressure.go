@@ -0,0 +1,76 @@
+package synopcsv
+
+import (
+	"math"
+	"time"
+)
+
+// Constants of the standard atmosphere
+const (
+	standardGravity   = 9.80665 // g0, in m/s²
+	StandardLapseRate = 0.0065  // decrease of temperature with altitude, in K/m
+)
+
+// DefaultSeaPressureTolerance is the difference in Pa between reported and reduced sea level pressures accepted by CheckSeaPressures
+const DefaultSeaPressureTolerance = 100
+
+// ReduceToSeaLevel reduces pressure in Pa measured at altitude in m and temperature in K to sea level, with the hypsometric equation.
+// The mean temperature of the fictitious air column below the station assumes the standard lapse rate.
+func ReduceToSeaLevel(pressure, altitude, temperature float64) float64 {
+	mean := temperature + StandardLapseRate*altitude/2
+	return pressure * math.Exp(standardGravity*altitude/(dryAirGasConstant*mean))
+}
+
+// ReducedSeaPressure returns PressureStation reduced to sea level in Pa, using the altitude of s and Temperature
+func (m Measure) ReducedSeaPressure(s Station) (float64, bool) {
+	if m.PressureStation == nil || m.Temperature == nil {
+		return 0, false
+	}
+	return ReduceToSeaLevel(float64(*m.PressureStation), s.Altitude, *m.Temperature), true
+}
+
+// SeaPressureDifference returns the difference in Pa between the reported SeaPressure and the one reduced from PressureStation
+func (m Measure) SeaPressureDifference(s Station) (float64, bool) {
+	p, ok := m.ReducedSeaPressure(s)
+	if !ok || m.SeaPressure == nil {
+		return 0, false
+	}
+	return float64(*m.SeaPressure) - p, true
+}
+
+// PressureAnomaly is a measure whose reported sea level pressure does not match the one reduced from the station pressure
+type PressureAnomaly struct {
+	StationID string
+	Date      time.Time
+	Reported  float64 // SeaPressure, in Pa
+	Reduced   float64 // in Pa
+}
+
+// Difference returns the reported minus the reduced sea level pressure, in Pa
+func (a PressureAnomaly) Difference() float64 {
+	return a.Reported - a.Reduced
+}
+
+// CheckSeaPressures returns the measures whose reported and reduced sea level pressures differ by more than tolerance in Pa.
+// Measures of unknown stations, or missing a pressure or the temperature, are not checked.
+func CheckSeaPressures(measures []Measure, stations []Station, tolerance float64) []PressureAnomaly {
+	byID := make(map[string]Station, len(stations))
+	for _, s := range stations {
+		byID[s.ID] = s
+	}
+	var anomalies []PressureAnomaly
+	for _, m := range measures {
+		s, ok := byID[m.StationID]
+		if !ok {
+			continue
+		}
+		p, ok := m.ReducedSeaPressure(s)
+		if !ok || m.SeaPressure == nil {
+			continue
+		}
+		if a := (PressureAnomaly{StationID: m.StationID, Date: m.Date, Reported: float64(*m.SeaPressure), Reduced: p}); math.Abs(a.Difference()) > tolerance {
+			anomalies = append(anomalies, a)
+		}
+	}
+	return anomalies
+}
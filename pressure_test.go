@@ -0,0 +1,38 @@
+package synopcsv_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jfyuen/synopcsv"
+	"github.com/jfyuen/synopcsv/synopcsvtest/fixture"
+)
+
+func TestReduceToSeaLevel(t *testing.T) {
+	if p := synopcsv.ReduceToSeaLevel(101325, 0, 288.15); p != 101325 {
+		t.Errorf("Pressure at sea level should not change, got %v", p)
+	}
+	// standard atmosphere at 1000 m: 89876 Pa, 281.65 K
+	if p := synopcsv.ReduceToSeaLevel(89876, 1000, 281.65); math.Abs(p-101325) > 50 {
+		t.Errorf("Invalid reduced pressure %v, expected 101325", p)
+	}
+}
+
+func TestCheckSeaPressures(t *testing.T) {
+	stations := fixture.Stations(t)
+	measures := fixture.Measures(t, "synop.2017050100.csv")
+
+	if anomalies := synopcsv.CheckSeaPressures(measures, stations, synopcsv.DefaultSeaPressureTolerance); len(anomalies) != 0 {
+		t.Errorf("Unexpected anomalies in reported pressures: %+v", anomalies)
+	}
+	for i := range measures {
+		if measures[i].StationID == "07149" {
+			p := *measures[i].SeaPressure + 500
+			measures[i].SeaPressure = &p
+		}
+	}
+	anomalies := synopcsv.CheckSeaPressures(measures, stations, synopcsv.DefaultSeaPressureTolerance)
+	if len(anomalies) != 1 || anomalies[0].StationID != "07149" || math.Abs(anomalies[0].Difference()-500) > 20 {
+		t.Errorf("Invalid anomalies %+v, expected a 500 Pa difference for 07149", anomalies)
+	}
+}
@@ -0,0 +1,76 @@
+package proj
+
+import "math"
+
+// lambertConformalConic is a Lambert conformal conic projection, with the notations of IGN note NT/G 71
+type lambertConformalConic struct {
+	code   string
+	e      float64 // first eccentricity of the ellipsoid
+	n      float64 // exponent of the projection
+	c      float64 // constant of the projection, in m
+	lon0   float64 // longitude of the central meridian, in radians
+	xs, ys float64 // coordinates of the pole, in m
+}
+
+// newLambertConformalConic2SP returns the Lambert projection secant at latitudes lat1 and lat2, of origin lon0, lat0 at x0, y0.
+// Angles are in degrees.
+func newLambertConformalConic2SP(code string, a, f, lon0, lat0, lat1, lat2, x0, y0 float64) *lambertConformalConic {
+	e := math.Sqrt(f * (2 - f))
+	p := &lambertConformalConic{code: code, e: e, lon0: radians(lon0)}
+	phi1, phi2 := radians(lat1), radians(lat2)
+	m := func(phi float64) float64 { return math.Cos(phi) / math.Sqrt(1-e*e*math.Sin(phi)*math.Sin(phi)) }
+	p.n = math.Log(m(phi2)/m(phi1)) / (p.isometricLatitude(phi1) - p.isometricLatitude(phi2))
+	p.c = a * m(phi1) / p.n * math.Exp(p.n*p.isometricLatitude(phi1))
+	p.xs = x0
+	p.ys = y0 + p.c*math.Exp(-p.n*p.isometricLatitude(radians(lat0)))
+	return p
+}
+
+// isometricLatitude returns the isometric latitude of phi in radians
+func (p *lambertConformalConic) isometricLatitude(phi float64) float64 {
+	s := p.e * math.Sin(phi)
+	return math.Log(math.Tan(math.Pi/4+phi/2)) - p.e/2*math.Log((1+s)/(1-s))
+}
+
+// latitude returns the latitude in radians of the isometric latitude l
+func (p *lambertConformalConic) latitude(l float64) float64 {
+	phi := 2*math.Atan(math.Exp(l)) - math.Pi/2
+	for i := 0; i < 20; i++ {
+		s := p.e * math.Sin(phi)
+		next := 2*math.Atan(math.Pow((1+s)/(1-s), p.e/2)*math.Exp(l)) - math.Pi/2
+		if math.Abs(next-phi) < 1e-12 {
+			return next
+		}
+		phi = next
+	}
+	return phi
+}
+
+func (p *lambertConformalConic) Code() string {
+	return p.code
+}
+
+func (p *lambertConformalConic) forward(lambda, phi float64) (x, y float64) {
+	r := p.c * math.Exp(-p.n*p.isometricLatitude(phi))
+	gamma := p.n * (lambda - p.lon0)
+	return p.xs + r*math.Sin(gamma), p.ys - r*math.Cos(gamma)
+}
+
+func (p *lambertConformalConic) Forward(lon, lat float64) (x, y float64) {
+	return p.forward(radians(lon), radians(lat))
+}
+
+func (p *lambertConformalConic) inverse(x, y float64) (lambda, phi float64) {
+	dx, dy := x-p.xs, y-p.ys
+	r := math.Hypot(dx, dy)
+	gamma := math.Atan2(dx, -dy)
+	return p.lon0 + gamma/p.n, p.latitude(-math.Log(r/p.c) / p.n)
+}
+
+func (p *lambertConformalConic) Inverse(x, y float64) (lon, lat float64) {
+	lambda, phi := p.inverse(x, y)
+	return degrees(lambda), degrees(phi)
+}
+
+// Lambert93 is the official projection of metropolitan France, EPSG:2154, defined on the GRS 80 ellipsoid
+var Lambert93 CRS = newLambertConformalConic2SP("EPSG:2154", wgs84SemiMajorAxis, grs80Flattening, 3, 46.5, 49, 44, 700000, 6600000)
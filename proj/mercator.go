@@ -0,0 +1,25 @@
+package proj
+
+import "math"
+
+// webMercatorMaxLatitude is the latitude in degrees beyond which Web Mercator is not defined, making the map square
+const webMercatorMaxLatitude = 85.05112877980659
+
+type webMercator struct{}
+
+func (webMercator) Code() string {
+	return "EPSG:3857"
+}
+
+// Forward clamps latitudes to ±85.05° as the projection diverges at the poles
+func (webMercator) Forward(lon, lat float64) (x, y float64) {
+	lat = math.Max(-webMercatorMaxLatitude, math.Min(webMercatorMaxLatitude, lat))
+	return wgs84SemiMajorAxis * radians(lon), wgs84SemiMajorAxis * math.Log(math.Tan(math.Pi/4+radians(lat)/2))
+}
+
+func (webMercator) Inverse(x, y float64) (lon, lat float64) {
+	return degrees(x / wgs84SemiMajorAxis), degrees(2*math.Atan(math.Exp(y/wgs84SemiMajorAxis)) - math.Pi/2)
+}
+
+// WebMercator is the spherical Mercator projection of web maps, EPSG:3857
+var WebMercator CRS = webMercator{}
@@ -0,0 +1,60 @@
+// Package proj projects WGS 84 longitudes and latitudes to the planar coordinates of usual coordinate reference systems, and back.
+// RGF93, the datum of Lambert-93, is considered identical to WGS 84, their difference being well under a meter.
+package proj
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CRS is a projected coordinate reference system
+type CRS interface {
+	// Code returns the EPSG code of the system, as "EPSG:2154"
+	Code() string
+	// Forward projects a longitude and a latitude in degrees to x (easting) and y (northing) in m
+	Forward(lon, lat float64) (x, y float64)
+	// Inverse returns the longitude and latitude in degrees of x, y in m
+	Inverse(x, y float64) (lon, lat float64)
+}
+
+// Reference ellipsoids
+const (
+	wgs84SemiMajorAxis = 6378137.0
+	wgs84Flattening    = 1 / 298.257223563
+	grs80Flattening    = 1 / 298.257222101
+)
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
+
+// ByCode returns the CRS with an EPSG code as "EPSG:2154": Lambert-93, Web Mercator or a WGS 84 UTM zone
+func ByCode(code string) (CRS, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	switch code {
+	case Lambert93.Code():
+		return Lambert93, true
+	case WebMercator.Code():
+		return WebMercator, true
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(code, "EPSG:"))
+	if err != nil || !strings.HasPrefix(code, "EPSG:") {
+		return nil, false
+	}
+	zone := n % 100
+	if zone < 1 || zone > 60 {
+		return nil, false
+	}
+	switch n - zone {
+	case 32600:
+		return UTM(zone, false), true
+	case 32700:
+		return UTM(zone, true), true
+	}
+	return nil, false
+}
@@ -0,0 +1,104 @@
+package proj
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLambertConformalConic(t *testing.T) {
+	// test values of algorithm ALG0003 of IGN note NT/G 71, given to a centimeter with its rounded constants
+	p := &lambertConformalConic{e: 0.0824832568, n: 0.7604059656, c: 11603796.98, lon0: 0.04079234433, xs: 600000, ys: 5657616.674}
+	x, y := p.forward(0.145512099, 0.872664626)
+	if math.Abs(x-1029705.083) > 1e-2 || math.Abs(y-272723.849) > 1e-2 {
+		t.Errorf("Invalid projection (%v, %v), expected (1029705.083, 272723.849)", x, y)
+	}
+	lambda, phi := p.inverse(x, y)
+	if math.Abs(lambda-0.145512099) > 1e-9 || math.Abs(phi-0.872664626) > 1e-9 {
+		t.Errorf("Invalid inverse projection (%v, %v)", lambda, phi)
+	}
+}
+
+func TestLambert93Constants(t *testing.T) {
+	// constants published by IGN for Lambert-93
+	p := Lambert93.(*lambertConformalConic)
+	if math.Abs(p.n-0.7256077650) > 1e-10 || math.Abs(p.c-11754255.426) > 1e-3 || math.Abs(p.ys-12655612.050) > 1e-3 {
+		t.Errorf("Invalid Lambert-93 constants n=%v c=%v ys=%v", p.n, p.c, p.ys)
+	}
+	if x, y := Lambert93.Forward(3, 46.5); math.Abs(x-700000) > 1e-6 || math.Abs(y-6600000) > 1e-6 {
+		t.Errorf("Invalid origin (%v, %v)", x, y)
+	}
+}
+
+func TestUTM(t *testing.T) {
+	// CN Tower, Toronto
+	lon, lat := -(79 + 23.0/60 + 13.7/3600), 43+38.0/60+33.24/3600
+	crs := UTMAt(lon, lat)
+	if crs.Code() != "EPSG:32617" {
+		t.Fatalf("Invalid UTM zone %v, expected EPSG:32617", crs.Code())
+	}
+	if x, y := crs.Forward(lon, lat); math.Abs(x-630084) > 1 || math.Abs(y-4833438) > 1 {
+		t.Errorf("Invalid projection (%v, %v), expected (630084, 4833438)", x, y)
+	}
+	if x, y := UTM(31, true).Forward(3, 0); math.Abs(x-500000) > 1e-6 || math.Abs(y-10000000) > 1e-6 {
+		t.Errorf("Invalid origin (%v, %v)", x, y)
+	}
+}
+
+func TestUTMZone(t *testing.T) {
+	tests := []struct {
+		lon, lat float64
+		zone     int
+		south    bool
+	}{
+		{2.38, 48.72, 31, false},  // Orly
+		{-4.41, 48.44, 30, false}, // Brest
+		{9.48, 42.55, 32, false},  // Bastia
+		{55.53, -20.89, 40, true}, // La Réunion
+		{-52.36, 4.82, 22, false}, // Cayenne
+		{-61.0, 14.6, 20, false},  // Martinique
+		{140.0, -66.66, 54, true}, // Dumont d'Urville
+		{5.3, 60.4, 32, false},    // Bergen, Norway exception
+		{8.9, 72.5, 31, false},    // Svalbard exception
+		{9.1, 72.5, 33, false},    // Svalbard exception
+		{180, 0, 1, false},        // antimeridian
+		{179.99, -10, 60, true},
+	}
+	for _, test := range tests {
+		zone, south := UTMZone(test.lon, test.lat)
+		if zone != test.zone || south != test.south {
+			t.Errorf("Invalid UTM zone for (%v, %v): %v %v, expected %v %v", test.lon, test.lat, zone, south, test.zone, test.south)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	points := [][2]float64{{2.384333, 48.716833}, {-4.41, 48.44}, {9.48, 42.55}, {55.53, -20.89}, {140.0, -66.66}}
+	for _, p := range points {
+		for _, crs := range []CRS{Lambert93, WebMercator, UTMAt(p[0], p[1])} {
+			// 1e-8° is about a millimeter
+			lon, lat := crs.Inverse(crs.Forward(p[0], p[1]))
+			if math.Abs(lon-p[0]) > 1e-8 || math.Abs(lat-p[1]) > 1e-8 {
+				t.Errorf("Invalid round trip of %v in %v: (%v, %v)", p, crs.Code(), lon, lat)
+			}
+		}
+	}
+}
+
+func TestWebMercator(t *testing.T) {
+	if x, y := WebMercator.Forward(180, 90); math.Abs(x-20037508.342789244) > 1e-6 || math.Abs(y-20037508.342789244) > 1e-3 {
+		t.Errorf("Invalid bounds (%v, %v)", x, y)
+	}
+}
+
+func TestByCode(t *testing.T) {
+	for _, code := range []string{"EPSG:2154", "EPSG:3857", "EPSG:32631", "EPSG:32740"} {
+		if crs, ok := ByCode(code); !ok || crs.Code() != code {
+			t.Errorf("Invalid CRS for %v", code)
+		}
+	}
+	for _, code := range []string{"EPSG:4326", "EPSG:32661", "2154"} {
+		if _, ok := ByCode(code); ok {
+			t.Errorf("%v should not be supported", code)
+		}
+	}
+}
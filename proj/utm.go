@@ -0,0 +1,93 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+)
+
+// transverseMercator is a transverse Mercator projection on the WGS 84 ellipsoid, computed with the Krüger series to the third order
+// as in Karney, "Transverse Mercator with an accuracy of a few nanometers" (2011), accurate to a millimeter within 3900 km of the central meridian
+type transverseMercator struct {
+	code   string
+	lon0   float64 // in radians
+	k0     float64 // scale factor on the central meridian
+	x0, y0 float64 // false easting and northing, in m
+}
+
+// Krüger series coefficients for WGS 84
+var (
+	tmN            = wgs84Flattening / (2 - wgs84Flattening)
+	tmA            = wgs84SemiMajorAxis / (1 + tmN) * (1 + tmN*tmN/4 + tmN*tmN*tmN*tmN/64)
+	tmAlpha        = [3]float64{tmN/2 - 2*tmN*tmN/3 + 5*tmN*tmN*tmN/16, 13*tmN*tmN/48 - 3*tmN*tmN*tmN/5, 61 * tmN * tmN * tmN / 240}
+	tmBeta         = [3]float64{tmN/2 - 2*tmN*tmN/3 + 37*tmN*tmN*tmN/96, tmN*tmN/48 + tmN*tmN*tmN/15, 17 * tmN * tmN * tmN / 480}
+	tmDelta        = [3]float64{2*tmN - 2*tmN*tmN/3 - 2*tmN*tmN*tmN, 7*tmN*tmN/3 - 8*tmN*tmN*tmN/5, 56 * tmN * tmN * tmN / 15}
+	tmEccentricity = 2 * math.Sqrt(tmN) / (1 + tmN)
+)
+
+func (p *transverseMercator) Code() string {
+	return p.code
+}
+
+func (p *transverseMercator) Forward(lon, lat float64) (x, y float64) {
+	phi, dLambda := radians(lat), radians(lon)-p.lon0
+	t := math.Sinh(math.Atanh(math.Sin(phi)) - tmEccentricity*math.Atanh(tmEccentricity*math.Sin(phi)))
+	xi := math.Atan2(t, math.Cos(dLambda))
+	eta := math.Atanh(math.Sin(dLambda) / math.Sqrt(1+t*t))
+	e, n := eta, xi
+	for j, a := range tmAlpha {
+		k := 2 * float64(j+1)
+		e += a * math.Cos(k*xi) * math.Sinh(k*eta)
+		n += a * math.Sin(k*xi) * math.Cosh(k*eta)
+	}
+	return p.x0 + p.k0*tmA*e, p.y0 + p.k0*tmA*n
+}
+
+func (p *transverseMercator) Inverse(x, y float64) (lon, lat float64) {
+	xi := (y - p.y0) / (p.k0 * tmA)
+	eta := (x - p.x0) / (p.k0 * tmA)
+	xi1, eta1 := xi, eta
+	for j, b := range tmBeta {
+		k := 2 * float64(j+1)
+		xi1 -= b * math.Sin(k*xi) * math.Cosh(k*eta)
+		eta1 -= b * math.Cos(k*xi) * math.Sinh(k*eta)
+	}
+	chi := math.Asin(math.Sin(xi1) / math.Cosh(eta1))
+	phi := chi
+	for j, d := range tmDelta {
+		phi += d * math.Sin(2*float64(j+1)*chi)
+	}
+	return degrees(p.lon0 + math.Atan2(math.Sinh(eta1), math.Cos(xi1))), degrees(phi)
+}
+
+// UTM returns the WGS 84 UTM projection of zone, between 1 and 60, in the southern hemisphere if south.
+// Its EPSG code is 326zz in the northern hemisphere and 327zz in the southern one.
+func UTM(zone int, south bool) CRS {
+	p := &transverseMercator{code: fmt.Sprintf("EPSG:326%02d", zone), lon0: radians(float64(6*zone - 183)), k0: 0.9996, x0: 500000}
+	if south {
+		p.code = fmt.Sprintf("EPSG:327%02d", zone)
+		p.y0 = 10000000
+	}
+	return p
+}
+
+// UTMZone returns the UTM zone of a longitude and latitude in degrees, with the exceptions of Norway and Svalbard,
+// and whether it is in the southern hemisphere
+func UTMZone(lon, lat float64) (zone int, south bool) {
+	lon = math.Mod(math.Mod(lon+180, 360)+360, 360) - 180
+	zone = int(math.Floor((lon+180)/6)) + 1
+	if zone > 60 {
+		zone = 60
+	}
+	switch {
+	case lat >= 56 && lat < 64 && lon >= 3 && lon < 12:
+		zone = 32
+	case lat >= 72 && lat < 84 && lon >= 0 && lon < 42:
+		zone = 2*int(math.Floor((lon+3)/12)) + 31
+	}
+	return zone, lat < 0
+}
+
+// UTMAt returns the UTM projection of the zone of a longitude and latitude in degrees
+func UTMAt(lon, lat float64) CRS {
+	return UTM(UTMZone(lon, lat))
+}
@@ -0,0 +1,60 @@
+package synopcsv
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/jfyuen/synopcsv/proj"
+	"github.com/pkg/errors"
+)
+
+// Projected returns the coordinates of s in crs, in m
+func (s Station) Projected(crs proj.CRS) (x, y float64) {
+	return crs.Forward(s.Longitude, s.Latitude)
+}
+
+// UTM returns the UTM projection of the zone of s, to be used for overseas stations outside of Lambert-93
+func (s Station) UTM() proj.CRS {
+	return proj.UTMAt(s.Longitude, s.Latitude)
+}
+
+// ProjectedStation is a station with its coordinates in a projected coordinate reference system
+type ProjectedStation struct {
+	Station
+	X, Y float64 // in m
+}
+
+// ProjectStations returns stations with their coordinates in crs
+func ProjectStations(stations []Station, crs proj.CRS) []ProjectedStation {
+	projected := make([]ProjectedStation, len(stations))
+	for i, s := range stations {
+		x, y := s.Projected(crs)
+		projected[i] = ProjectedStation{Station: s, X: x, Y: y}
+	}
+	return projected
+}
+
+// WriteProjectedStationsCSV writes stations as WriteStationsCSV does, with their coordinates in crs in X and Y columns
+// instead of latitude and longitude
+func WriteProjectedStationsCSV(out io.Writer, stations []Station, crs proj.CRS) error {
+	w := csv.NewWriter(out)
+	w.Comma = ';'
+	if err := w.Write([]string{"ID", "Nom", "X", "Y", "Altitude"}); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, s := range ProjectStations(stations, crs) {
+		record := []string{
+			s.ID,
+			s.Name,
+			strconv.FormatFloat(s.X, 'f', 2, 64),
+			strconv.FormatFloat(s.Y, 'f', 2, 64),
+			strconv.FormatFloat(s.Altitude, 'f', -1, 64),
+		}
+		if err := w.Write(record); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	w.Flush()
+	return errors.WithStack(w.Error())
+}
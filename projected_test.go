@@ -0,0 +1,35 @@
+package synopcsv
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/jfyuen/synopcsv/proj"
+)
+
+func TestStationProjected(t *testing.T) {
+	s := Station{ID: "61980", Name: "ST DENIS-GILLOT", Latitude: -20.8925, Longitude: 55.528667, Altitude: 8}
+	if code := s.UTM().Code(); code != "EPSG:32740" {
+		t.Errorf("Invalid UTM zone %v for La Réunion, expected EPSG:32740", code)
+	}
+	x, y := s.Projected(s.UTM())
+	if lon, lat := s.UTM().Inverse(x, y); math.Abs(lon-s.Longitude) > 1e-8 || math.Abs(lat-s.Latitude) > 1e-8 {
+		t.Errorf("Invalid projection (%v, %v) of %v", x, y, s)
+	}
+}
+
+func TestWriteProjectedStationsCSV(t *testing.T) {
+	stations := []Station{{ID: "00000", Name: "ORIGIN", Latitude: 46.5, Longitude: 3, Altitude: 10}}
+	buf := new(bytes.Buffer)
+	if err := WriteProjectedStationsCSV(buf, stations, proj.Lambert93); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expected := "ID;Nom;X;Y;Altitude\n00000;ORIGIN;700000.00;6600000.00;10\n"
+	if buf.String() != expected {
+		t.Errorf("Invalid CSV\n%v\nexpected\n%v", buf.String(), expected)
+	}
+	if p := ProjectStations(stations, proj.WebMercator); len(p) != 1 || p[0].ID != "00000" || math.Abs(p[0].X-333958.47) > 0.01 {
+		t.Errorf("Invalid projected stations %+v", p)
+	}
+}
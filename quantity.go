@@ -0,0 +1,157 @@
+package synopcsv
+
+import (
+	"math"
+	"time"
+
+	"github.com/jfyuen/synopcsv/units"
+)
+
+func temperatureIn(k *float64, u units.Temperature) (float64, bool) {
+	if k == nil {
+		return 0, false
+	}
+	return u.FromKelvin(*k), true
+}
+
+func pressureIn(pa *int, u units.Pressure) (float64, bool) {
+	if pa == nil {
+		return 0, false
+	}
+	return u.FromPa(float64(*pa)), true
+}
+
+func speedIn(v *float64, u units.Speed) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	return u.FromMetersPerSecond(*v), true
+}
+
+func lengthIn(m *float64, u units.Length) (float64, bool) {
+	if m == nil {
+		return 0, false
+	}
+	return u.FromMeters(*m), true
+}
+
+// TemperatureIn returns Temperature in u
+func (m Measure) TemperatureIn(u units.Temperature) (float64, bool) {
+	return temperatureIn(m.Temperature, u)
+}
+
+// DewPointIn returns DewPoint in u
+func (m Measure) DewPointIn(u units.Temperature) (float64, bool) {
+	return temperatureIn(m.DewPoint, u)
+}
+
+// WetBulbTemperatureIn returns WetBulbTemperature in u
+func (m Measure) WetBulbTemperatureIn(u units.Temperature) (float64, bool) {
+	return temperatureIn(m.WetBulbTemperature, u)
+}
+
+// MinimalTemperatureIn returns the minimal temperature over the last 12 or 24 hours in u
+func (m Measure) MinimalTemperatureIn(hours int, u units.Temperature) (float64, bool) {
+	switch hours {
+	case 12:
+		return temperatureIn(m.MinimalTemperatureOverLast12Hours, u)
+	case 24:
+		return temperatureIn(m.MinimalTemperatureOverLast24Hours, u)
+	default:
+		return 0, false
+	}
+}
+
+// MaximalTemperatureIn returns the maximal temperature over the last 12 or 24 hours in u
+func (m Measure) MaximalTemperatureIn(hours int, u units.Temperature) (float64, bool) {
+	switch hours {
+	case 12:
+		return temperatureIn(m.MaximalTemperatureOverLast12Hours, u)
+	case 24:
+		return temperatureIn(m.MaximalTemperatureOverLast24Hours, u)
+	default:
+		return 0, false
+	}
+}
+
+// SeaPressureIn returns SeaPressure in u
+func (m Measure) SeaPressureIn(u units.Pressure) (float64, bool) {
+	return pressureIn(m.SeaPressure, u)
+}
+
+// StationPressureIn returns PressureStation in u
+func (m Measure) StationPressureIn(u units.Pressure) (float64, bool) {
+	return pressureIn(m.PressureStation, u)
+}
+
+// PressureVariationIn returns PressureVariation over the last 3 hours in u
+func (m Measure) PressureVariationIn(u units.Pressure) (float64, bool) {
+	return pressureIn(m.PressureVariation, u)
+}
+
+// WindSpeedIn returns WindSpeed in u
+func (m Measure) WindSpeedIn(u units.Speed) (float64, bool) {
+	return speedIn(m.WindSpeed, u)
+}
+
+// GustIn returns Last10MinutesGust in u
+func (m Measure) GustIn(u units.Speed) (float64, bool) {
+	return speedIn(m.Last10MinutesGust, u)
+}
+
+// GustOverPeriodIn returns GustOverPeriod in u
+func (m Measure) GustOverPeriodIn(u units.Speed) (float64, bool) {
+	return speedIn(m.GustOverPeriod, u)
+}
+
+// VisibilityIn returns HorizontalVisibility in u
+func (m Measure) VisibilityIn(u units.Length) (float64, bool) {
+	return lengthIn(m.HorizontalVisibility, u)
+}
+
+// SnowHeightIn returns SnowHeight in u
+func (m Measure) SnowHeightIn(u units.Length) (float64, bool) {
+	return lengthIn(m.SnowHeight, u)
+}
+
+// FreshSnowHeightIn returns FreshSnowHeight in u
+func (m Measure) FreshSnowHeightIn(u units.Length) (float64, bool) {
+	return lengthIn(m.FreshSnowHeight, u)
+}
+
+// PrecipitationIn returns the precipitation over the last 1, 3, 6, 12 or 24 hours in u
+func (m Measure) PrecipitationIn(hours int, u units.Length) (float64, bool) {
+	var mm *float64
+	switch hours {
+	case 1:
+		mm = m.PrecipitationOverLastHour
+	case 3:
+		mm = m.PrecipitationOverLast3Hours
+	case 6:
+		mm = m.PrecipitationOverLast6Hours
+	case 12:
+		mm = m.PrecipitationOverLast12Hours
+	case 24:
+		mm = m.PrecipitationOverLast24Hours
+	}
+	if mm == nil {
+		return 0, false
+	}
+	return u.FromMeters(units.Millimeters.ToMeters(*mm)), true
+}
+
+// FreshSnowPeriodDuration returns FreshSnowPeriod as a duration, periods being negative in files as they precede the measure
+func (m Measure) FreshSnowPeriodDuration() (time.Duration, bool) {
+	if m.FreshSnowPeriod == nil {
+		return 0, false
+	}
+	return time.Duration(math.Abs(*m.FreshSnowPeriod) * float64(6*time.Minute)), true
+}
+
+// GustPeriodDuration returns GustPeriod as a duration, periods being negative in files as they precede the measure
+func (m Measure) GustPeriodDuration() (time.Duration, bool) {
+	if m.GustPeriod == nil {
+		return 0, false
+	}
+	return time.Duration(math.Abs(*m.GustPeriod) * float64(time.Minute)), true
+}
@@ -0,0 +1,41 @@
+package synopcsv
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfyuen/synopcsv/units"
+)
+
+func TestQuantities(t *testing.T) {
+	measures, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	m := measures[1]
+	if v, ok := m.TemperatureIn(units.Celsius); !ok || math.Abs(v-11) > 1e-9 {
+		t.Errorf("Invalid temperature: %v", v)
+	}
+	if v, ok := m.SeaPressureIn(units.HPa); !ok || v != 1016.2 {
+		t.Errorf("Invalid sea pressure: %v", v)
+	}
+	if v, ok := m.WindSpeedIn(units.KilometersPerHour); !ok || math.Abs(v-12.96) > 1e-9 {
+		t.Errorf("Invalid wind speed: %v", v)
+	}
+	if v, ok := m.PrecipitationIn(3, units.Millimeters); !ok || math.Abs(v-1.2) > 1e-9 {
+		t.Errorf("Invalid precipitation: %v", v)
+	}
+	if _, ok := m.PrecipitationIn(6, units.Millimeters); ok {
+		t.Error("precipitation over 6 hours should not be available")
+	}
+	if d, ok := m.GustPeriodDuration(); !ok || d != 10*time.Minute {
+		t.Errorf("Invalid gust period: %v", d)
+	}
+	period := -120.0
+	m.FreshSnowPeriod = &period
+	if d, ok := m.FreshSnowPeriodDuration(); !ok || d != 12*time.Hour {
+		t.Errorf("Invalid fresh snow period: %v", d)
+	}
+}
@@ -1,12 +1,9 @@
 package synopcsv
 
 import (
-	"bytes"
+	"context"
 	"io"
-	"io/ioutil"
-	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/pkg/errors"
 )
@@ -20,53 +17,46 @@ type Station struct {
 	Altitude  float64
 }
 
-func fetchURL(url string) (r io.Reader, err error) {
-	var client = &http.Client{
-		Timeout: time.Second * 10,
-	}
-	response, err := client.Get(url)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	defer func() {
-		errClose := response.Body.Close()
-		if err == nil {
-			err = errors.WithStack(errClose)
-		}
-	}()
-	b, err := ioutil.ReadAll(response.Body)
-	// stations, err = parseStationCSV(response.Body)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return bytes.NewReader(b), nil
-}
-
 // FetchStationCSV retrieve station lists as csv from
 // https://donneespubliques.meteofrance.fr/donnees_libres/Txt/Synop/postesSynop.csv
 func FetchStationCSV() (r io.Reader, err error) {
-	url := "https://donneespubliques.meteofrance.fr/donnees_libres/Txt/Synop/postesSynop.csv"
-	return fetchURL(url)
+	return DefaultFetcher.FetchStationCSV(context.Background())
 }
 
 // ParseStationsCSV parses stations from a CSV file formated as "https://donneespubliques.meteofrance.fr/donnees_libres/Txt/Synop/postesSynop.csv"
 func ParseStationsCSV(in io.Reader) ([]Station, error) {
-	csvVals, err := parseCSV(in)
-	if err != nil {
-		return nil, err
-	}
+	r := newCSVReader(in)
 	stations := make([]Station, 0)
-	for _, row := range csvVals.rows {
-		station := Station{ID: row["ID"], Name: row["Nom"]}
-		station.Latitude, err = strconv.ParseFloat(row["Latitude"], 64)
-		if err != nil {
-			return nil, err
+	for {
+		row, err := r.next()
+		if err == io.EOF {
+			break
 		}
-		station.Longitude, err = strconv.ParseFloat(row["Longitude"], 64)
 		if err != nil {
 			return nil, err
 		}
-		station.Altitude, err = strconv.ParseFloat(row["Altitude"], 64)
+		station := Station{ID: row["ID"], Name: row["Nom"]}
+		parseFloat := func(column string) float64 {
+			if err != nil {
+				return 0
+			}
+			var val float64
+			val, err = strconv.ParseFloat(row[column], 64)
+			if err != nil {
+				err = errors.WithStack(&ParseError{
+					Line:     r.line,
+					Column:   column,
+					Value:    row[column],
+					Station:  station.ID,
+					Expected: "float",
+					Err:      err,
+				})
+			}
+			return val
+		}
+		station.Latitude = parseFloat("Latitude")
+		station.Longitude = parseFloat("Longitude")
+		station.Altitude = parseFloat("Altitude")
 		if err != nil {
 			return nil, err
 		}
@@ -1,17 +1,26 @@
 package synopcsv
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
+
+	"github.com/jfyuen/synopcsv/synopcsvtest"
 )
 
 func TestFetchStationCSV(t *testing.T) {
-	r, err := FetchStationCSV()
+	server := synopcsvtest.NewFixtureServer()
+	defer server.Close()
+
+	f := &Fetcher{BaseURL: server.BaseURL}
+	r, err := f.FetchStationCSV(context.Background())
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 	stations, err := ParseStationsCSV(r)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 	stationCount := 62
 	if len(stations) != stationCount {
@@ -23,3 +32,18 @@ func TestFetchStationCSV(t *testing.T) {
 		t.Errorf("last station do not match originale value: %v vs %v", last, stations[len(stations)-1])
 	}
 }
+
+func TestParseStationsCSVError(t *testing.T) {
+	in := `ID;Nom;Latitude;Longitude;Altitude
+07005;ABBEVILLE;50.136000;1.834000;69
+07015;LILLE-LESQUIN;50.570000;3.0975000;47.0.1
+`
+	_, err := ParseStationsCSV(strings.NewReader(in))
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a ParseError, got %v", err)
+	}
+	if parseErr.Line != 3 || parseErr.Column != "Altitude" || parseErr.Station != "07015" || parseErr.Value != "47.0.1" {
+		t.Errorf("Invalid error context: %+v", parseErr)
+	}
+}
@@ -0,0 +1,150 @@
+package synopcsv
+
+import (
+	"math"
+	"sort"
+)
+
+// earthRadius is the mean Earth radius in m
+const earthRadius = 6371008.8
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+// Distance returns the great-circle distance in m between two points given by latitude and longitude in degrees
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	dLat := radians(lat2 - lat1)
+	dLon := radians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(radians(lat1))*math.Cos(radians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Bearing returns the initial bearing in degrees, clockwise from north, to go from the first point to the second one
+func Bearing(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dLon := radians(lon2 - lon1)
+	y := math.Sin(dLon) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLon)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// DistanceTo returns the great-circle distance in m from s to o
+func (s Station) DistanceTo(o Station) float64 {
+	return Distance(s.Latitude, s.Longitude, o.Latitude, o.Longitude)
+}
+
+// BearingTo returns the initial bearing in degrees from s to o
+func (s Station) BearingTo(o Station) float64 {
+	return Bearing(s.Latitude, s.Longitude, o.Latitude, o.Longitude)
+}
+
+// StationIndex answers spatial queries on stations
+type StationIndex struct {
+	stations []Station // sorted by latitude
+	byID     map[string]int
+}
+
+// NewStationIndex returns an index of stations
+func NewStationIndex(stations []Station) *StationIndex {
+	idx := &StationIndex{stations: append([]Station(nil), stations...), byID: make(map[string]int, len(stations))}
+	sort.SliceStable(idx.stations, func(i, j int) bool { return idx.stations[i].Latitude < idx.stations[j].Latitude })
+	for i, s := range idx.stations {
+		idx.byID[s.ID] = i
+	}
+	return idx
+}
+
+// Len returns the number of stations in the index
+func (idx *StationIndex) Len() int {
+	return len(idx.stations)
+}
+
+// Station returns the station with id
+func (idx *StationIndex) Station(id string) (Station, bool) {
+	i, ok := idx.byID[id]
+	if !ok {
+		return Station{}, false
+	}
+	return idx.stations[i], true
+}
+
+// Distance returns the great-circle distance in m between the stations with id1 and id2
+func (idx *StationIndex) Distance(id1, id2 string) (float64, bool) {
+	s1, ok1 := idx.Station(id1)
+	s2, ok2 := idx.Station(id2)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	return s1.DistanceTo(s2), true
+}
+
+// Bearing returns the initial bearing in degrees from the station with id1 to the one with id2
+func (idx *StationIndex) Bearing(id1, id2 string) (float64, bool) {
+	s1, ok1 := idx.Station(id1)
+	s2, ok2 := idx.Station(id2)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	return s1.BearingTo(s2), true
+}
+
+// sortByDistance sorts stations from the nearest to the farthest of lat, lon
+func sortByDistance(stations []Station, lat, lon float64) {
+	distances := make(map[string]float64, len(stations))
+	for _, s := range stations {
+		distances[s.ID] = Distance(lat, lon, s.Latitude, s.Longitude)
+	}
+	sort.SliceStable(stations, func(i, j int) bool { return distances[stations[i].ID] < distances[stations[j].ID] })
+}
+
+// Nearest returns the n stations nearest to lat, lon in degrees, from the nearest one, none if n is not positive
+func (idx *StationIndex) Nearest(lat, lon float64, n int) []Station {
+	if n <= 0 {
+		return nil
+	}
+	stations := append([]Station(nil), idx.stations...)
+	sortByDistance(stations, lat, lon)
+	if n < len(stations) {
+		stations = stations[:n]
+	}
+	return stations
+}
+
+// Within returns the stations at most radius m away from lat, lon in degrees, from the nearest one
+func (idx *StationIndex) Within(lat, lon, radius float64) []Station {
+	// stations farther in latitude than radius cannot be within it
+	dLat := radius / earthRadius * 180 / math.Pi
+	from := sort.Search(len(idx.stations), func(i int) bool { return idx.stations[i].Latitude >= lat-dLat })
+	var stations []Station
+	for _, s := range idx.stations[from:] {
+		if s.Latitude > lat+dLat {
+			break
+		}
+		if Distance(lat, lon, s.Latitude, s.Longitude) <= radius {
+			stations = append(stations, s)
+		}
+	}
+	sortByDistance(stations, lat, lon)
+	return stations
+}
+
+// InBox returns the stations inside the bounding box, sorted by latitude.
+// minLon may be greater than maxLon for a box crossing the antimeridian.
+func (idx *StationIndex) InBox(minLat, minLon, maxLat, maxLon float64) []Station {
+	from := sort.Search(len(idx.stations), func(i int) bool { return idx.stations[i].Latitude >= minLat })
+	var stations []Station
+	for _, s := range idx.stations[from:] {
+		if s.Latitude > maxLat {
+			break
+		}
+		inLon := s.Longitude >= minLon && s.Longitude <= maxLon
+		if minLon > maxLon {
+			inLon = s.Longitude >= minLon || s.Longitude <= maxLon
+		}
+		if inLon {
+			stations = append(stations, s)
+		}
+	}
+	return stations
+}
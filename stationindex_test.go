@@ -0,0 +1,81 @@
+package synopcsv_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jfyuen/synopcsv"
+	"github.com/jfyuen/synopcsv/synopcsvtest/fixture"
+)
+
+func fixtureStationIndex(t *testing.T) *synopcsv.StationIndex {
+	return synopcsv.NewStationIndex(fixture.Stations(t))
+}
+
+func stationIDs(stations []synopcsv.Station) []string {
+	ids := make([]string, len(stations))
+	for i, s := range stations {
+		ids[i] = s.ID
+	}
+	return ids
+}
+
+func TestStationIndexDistance(t *testing.T) {
+	idx := fixtureStationIndex(t)
+	if d, ok := idx.Distance("07149", "07481"); !ok || math.Abs(d-389.7e3) > 1e3 {
+		t.Errorf("Invalid distance from Orly to Lyon: %v m", d)
+	}
+	if b, ok := idx.Bearing("07149", "07481"); !ok || math.Abs(b-147.5) > 0.5 {
+		t.Errorf("Invalid bearing from Orly to Lyon: %v°", b)
+	}
+	if _, ok := idx.Distance("07149", "00000"); ok {
+		t.Errorf("Distance to an unknown station should not be available")
+	}
+}
+
+func TestStationIndexNearest(t *testing.T) {
+	idx := fixtureStationIndex(t)
+	nearest := idx.Nearest(48.8566, 2.3522, 3)
+	if len(nearest) != 3 || nearest[0].ID != "07149" {
+		t.Fatalf("Invalid nearest stations from Paris: %v", stationIDs(nearest))
+	}
+	for i := 1; i < len(nearest); i++ {
+		if synopcsv.Distance(48.8566, 2.3522, nearest[i-1].Latitude, nearest[i-1].Longitude) > synopcsv.Distance(48.8566, 2.3522, nearest[i].Latitude, nearest[i].Longitude) {
+			t.Errorf("Nearest stations are not sorted by distance: %v", stationIDs(nearest))
+		}
+	}
+	if all := idx.Nearest(0, 0, 1000); len(all) != idx.Len() {
+		t.Errorf("Invalid number of stations %v, expected %v", len(all), idx.Len())
+	}
+	for _, n := range []int{0, -1} {
+		if none := idx.Nearest(0, 0, n); len(none) != 0 {
+			t.Errorf("No station expected for n = %v, got %v", n, stationIDs(none))
+		}
+	}
+}
+
+func TestStationIndexWithin(t *testing.T) {
+	idx := fixtureStationIndex(t)
+	within := idx.Within(48.8566, 2.3522, 20e3)
+	if len(within) != 1 || within[0].ID != "07149" {
+		t.Errorf("Invalid stations within 20 km of Paris: %v", stationIDs(within))
+	}
+	for _, s := range idx.Within(45.7265, 5.077833, 200e3) {
+		if d := synopcsv.Distance(45.7265, 5.077833, s.Latitude, s.Longitude); d > 200e3 {
+			t.Errorf("Station %v is %v m away", s.ID, d)
+		}
+	}
+}
+
+func TestStationIndexInBox(t *testing.T) {
+	idx := fixtureStationIndex(t)
+	box := idx.InBox(50, 1, 51, 4)
+	if ids := stationIDs(box); len(ids) != 2 || ids[0] != "07005" || ids[1] != "07015" {
+		t.Errorf("Invalid stations in the north of France: %v", ids)
+	}
+	for _, s := range idx.InBox(-90, 170, 90, -170) {
+		if s.Longitude < 170 && s.Longitude > -170 {
+			t.Errorf("Station %v at %v is outside a box crossing the antimeridian", s.ID, s.Longitude)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package synopcsv
+
+// Status tells if a Measure field is available, or why it is not
+type Status int
+
+// Field statuses
+const (
+	Available     Status = iota // the value is available
+	NotReported                 // the value was not transmitted, as "mq"
+	NotObservable               // the value could not be observed, as low clouds hidden by fog (cl=62)
+	Invalid                     // the value was invalid, as an empty cell, and dropped in lenient mode, it is written as "mq"
+)
+
+func (s Status) String() string {
+	switch s {
+	case Available:
+		return "available"
+	case NotReported:
+		return "not reported"
+	case NotObservable:
+		return "not observable"
+	case Invalid:
+		return "invalid"
+	default:
+		return "unknown"
+	}
+}
+
+// notObservableCodes holds the code used by code tables to report a value that could not be observed
+var notObservableCodes = map[string]int{
+	"0513": 62, // cl
+	"0515": 61, // cm
+	"0509": 60, // ch
+	"0500": 59, // ctypeN
+}
+
+// statusBits holds a Status for each measure field on two bits, in two bitmaps indexed as measureFields.
+// Being an array, it keeps Measure comparable and copied by value.
+type statusBits [2]uint64
+
+func init() {
+	if len(measureFields) > 64 {
+		panic("too many measure fields for statusBits")
+	}
+}
+
+func (b statusBits) get(i int) Status {
+	return Status(b[0]>>uint(i)&1 | (b[1]>>uint(i)&1)<<1)
+}
+
+func (b *statusBits) set(i int, s Status) {
+	if s == NotReported {
+		// nil fields without status are not reported, so that equal statuses have equal bits
+		s = Available
+	}
+	for plane := range b {
+		if s>>uint(plane)&1 == 1 {
+			b[plane] |= 1 << uint(i)
+		} else {
+			b[plane] &^= 1 << uint(i)
+		}
+	}
+}
+
+// columnIndex maps CSV columns to their index in measureFields
+var columnIndex = func() map[string]int {
+	index := make(map[string]int, len(measureFields))
+	for i, f := range measureFields {
+		index[f.Column] = i
+	}
+	return index
+}()
+
+// Status tells if the field read from column is available, a nil field without a known status being NotReported
+func (m Measure) Status(column string) Status {
+	i, ok := columnIndex[column]
+	if !ok {
+		return NotReported
+	}
+	return m.fieldStatus(i)
+}
+
+func (m Measure) fieldStatus(i int) Status {
+	if measureFields[i].Value(m) != nil {
+		return Available
+	}
+	if s := m.status.get(i); s != Available {
+		return s
+	}
+	return NotReported
+}
+
+// SetStatus sets why the field read from column is not available, for measures not read from a CSV file
+func (m *Measure) SetStatus(column string, s Status) {
+	i, ok := columnIndex[column]
+	if !ok {
+		return
+	}
+	m.status.set(i, s)
+}
+
+// Status tells if the field is available in m, or why it is not
+func (f Field) Status(m Measure) Status {
+	return m.fieldStatus(columnIndex[f.Column])
+}
@@ -0,0 +1,77 @@
+package synopcsv
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMeasureStatus(t *testing.T) {
+	// 07005 does not report tend, and has middle clouds hidden by lower ones
+	in := strings.Replace(testMeasureCSV, "07005;20170501000000;101650;-30;", "07005;20170501000000;101650;mq;", 1)
+	measures, err := ParseMeasureCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	m := measures[0]
+	expected := map[string]Status{
+		"pmer": Available,
+		"tend": NotReported,
+		"tn12": NotReported,
+		"cl":   Available,
+		"cm":   NotObservable,
+		"ch":   NotObservable,
+	}
+	for column, status := range expected {
+		if s := m.Status(column); s != status {
+			t.Errorf("Invalid status for %v: %v, expected %v", column, s, status)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := WriteMeasureCSV(buf, measures); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !strings.Contains(buf.String(), "07005;20170501000000;101650;mq;8;250;2.1;283.45;281.65;88;20000;2;0;0;100;8;450;35;61;60;") {
+		t.Errorf("not observable values should be written with their code: %v", buf.String())
+	}
+	parsed, err := ParseMeasureCSV(buf)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !reflect.DeepEqual(measures, parsed) {
+		t.Error("statuses differ after writing and parsing them back")
+	}
+
+	var built Measure
+	built.SetStatus("cl", NotObservable)
+	if built.Status("cl") != NotObservable || built.Status("cm") != NotReported {
+		t.Errorf("Invalid status set: %v %v", built.Status("cl"), built.Status("cm"))
+	}
+}
+
+func TestSetStatusCopy(t *testing.T) {
+	var m1 Measure
+	m1.SetStatus("t", Invalid)
+	m2 := m1
+	m2.SetStatus("t", NotObservable)
+	m2.SetStatus("cl", Invalid)
+	if s := m1.Status("t"); s != Invalid {
+		t.Errorf("Status of the original measure changed to %v", s)
+	}
+	if s := m1.Status("cl"); s != NotReported {
+		t.Errorf("Status of the original measure changed to %v", s)
+	}
+	if s := m2.Status("t"); s != NotObservable {
+		t.Errorf("Invalid status %v of the copy, expected %v", s, NotObservable)
+	}
+	m2.SetStatus("cl", NotReported)
+	if m2.Status("cl") != NotReported || m1 == m2 {
+		t.Errorf("Copy should only differ by its t status")
+	}
+	m2.SetStatus("t", Invalid)
+	if m1 != m2 {
+		t.Errorf("Measures with the same statuses should be equal")
+	}
+}
@@ -0,0 +1,39 @@
+// Package fixture parses the SYNOP files embedded in synopcsvtest, for tests needing stations or measures
+package fixture
+
+import (
+	"testing"
+
+	"github.com/jfyuen/synopcsv"
+	"github.com/jfyuen/synopcsv/synopcsvtest"
+)
+
+// Stations returns the stations of postesSynop.csv, failing t if they cannot be read
+func Stations(t testing.TB) []synopcsv.Station {
+	t.Helper()
+	f, err := synopcsvtest.Fixtures().Open("postesSynop.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	stations, err := synopcsv.ParseStationsCSV(f)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return stations
+}
+
+// Measures returns the measures of the 3-hourly file name, as synop.2017050100.csv, failing t if they cannot be read
+func Measures(t testing.TB, name string) []synopcsv.Measure {
+	t.Helper()
+	f, err := synopcsvtest.Fixtures().Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	measures, err := synopcsv.ParseMeasureCSV(f)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return measures
+}
@@ -0,0 +1,53 @@
+// Package synopcsvtest provides an offline Météo-France server to test code fetching SYNOP files
+package synopcsvtest
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+)
+
+// Path is the directory of the SYNOP files on https://donneespubliques.meteofrance.fr, served as is by Server
+const Path = "/donnees_libres/Txt/Synop/"
+
+// Server serves SYNOP files from a local directory laid out as the Météo-France one:
+// postesSynop.csv, synop.YYYYMMDDHH.csv files and Archive/synop.YYYYMM.csv.gz archives
+type Server struct {
+	*httptest.Server
+	// BaseURL is to be used as synopcsv.Fetcher BaseURL to fetch from this server
+	BaseURL string
+}
+
+// NewServer starts a Server serving the files in dir, to be closed by the caller
+func NewServer(dir string) *Server {
+	return NewFSServer(os.DirFS(dir))
+}
+
+// NewFSServer starts a Server serving the files of fsys, to be closed by the caller
+func NewFSServer(fsys fs.FS) *Server {
+	mux := http.NewServeMux()
+	mux.Handle(Path, http.StripPrefix(Path, http.FileServer(http.FS(fsys))))
+	s := httptest.NewServer(mux)
+	return &Server{Server: s, BaseURL: s.URL + Path}
+}
+
+// NewFixtureServer starts a Server serving the fixtures of this package, to be closed by the caller:
+// the 62 stations of postesSynop.csv, synop.2017050100.csv and synop.2017050103.csv for all stations,
+// and the Archive/synop.201705.csv.gz archive for stations 07005, 07149, 07481 and 07650
+func NewFixtureServer() *Server {
+	return NewFSServer(Fixtures())
+}
+
+//go:embed testdata
+var testdata embed.FS
+
+// Fixtures returns the files served by NewFixtureServer, embedded in the package so they are available out of its source tree
+func Fixtures() fs.FS {
+	fixtures, err := fs.Sub(testdata, "testdata")
+	if err != nil {
+		panic(err) // testdata is a valid path
+	}
+	return fixtures
+}
@@ -0,0 +1,81 @@
+package synopcsv
+
+import "github.com/jfyuen/synopcsv/codes"
+
+// codeText returns the description of v in table, or an empty string when v is not available or not in the table
+func codeText(v *int, table *codes.Table, lang codes.Language) string {
+	if v == nil {
+		return ""
+	}
+	return table.Text(*v, lang)
+}
+
+// Text returns the description of the field value in m for fields holding a code, or an empty string
+func (f Field) Text(m Measure, lang codes.Language) string {
+	if p, ok := f.Value(m).(SpecialPhenomenon); ok {
+		return p.Text(lang)
+	}
+	table, ok := codes.ByID(f.Code)
+	if !ok {
+		return ""
+	}
+	v, ok := f.Value(m).(int)
+	if !ok {
+		return ""
+	}
+	return table.Text(v, lang)
+}
+
+// PressureTendencyText describes BarometricTrend
+func (m Measure) PressureTendencyText(lang codes.Language) string {
+	return codeText(m.BarometricTrend, codes.PressureTendency, lang)
+}
+
+// PresentWeatherText describes PresentTime, as "Moderate continuous rain"
+func (m Measure) PresentWeatherText(lang codes.Language) string {
+	return codeText(m.PresentTime, codes.PresentWeather, lang)
+}
+
+// PastWeather1Text describes PastTime1
+func (m Measure) PastWeather1Text(lang codes.Language) string {
+	return codeText(m.PastTime1, codes.PastWeather, lang)
+}
+
+// PastWeather2Text describes PastTime2
+func (m Measure) PastWeather2Text(lang codes.Language) string {
+	return codeText(m.PastTime2, codes.PastWeather, lang)
+}
+
+// LowCloudsText describes LowerLevelCloudType
+func (m Measure) LowCloudsText(lang codes.Language) string {
+	return codeText(m.LowerLevelCloudType, codes.LowClouds, lang)
+}
+
+// MiddleCloudsText describes MiddleLevelCloudType
+func (m Measure) MiddleCloudsText(lang codes.Language) string {
+	return codeText(m.MiddleLevelCloudType, codes.MiddleClouds, lang)
+}
+
+// HighCloudsText describes HigherLevelCloudType
+func (m Measure) HighCloudsText(lang codes.Language) string {
+	return codeText(m.HigherLevelCloudType, codes.HighClouds, lang)
+}
+
+// WetBulbIndicatorText describes TwMeasureMethod
+func (m Measure) WetBulbIndicatorText(lang codes.Language) string {
+	return codeText(m.TwMeasureMethod, codes.WetBulbIndicator, lang)
+}
+
+// GroundStateText describes GroundState
+func (m Measure) GroundStateText(lang codes.Language) string {
+	return codeText(m.GroundState, codes.GroundState, lang)
+}
+
+// CloudGenusText describes the cloud type of layer, from 1 to 4
+func (m Measure) CloudGenusText(layer int, lang codes.Language) string {
+	types := []*int{m.LevelCloudType1, m.LevelCloudType2, m.LevelCloudType3, m.LevelCloudType4}
+	if layer < 1 || layer > len(types) {
+		return ""
+	}
+	return codeText(types[layer-1], codes.CloudGenus, lang)
+}
@@ -0,0 +1,32 @@
+package synopcsv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jfyuen/synopcsv/codes"
+)
+
+func TestMeasureText(t *testing.T) {
+	measures, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	m := measures[1]
+	if text := m.PresentWeatherText(codes.English); text != "Slight continuous rain" {
+		t.Errorf("Invalid present weather: %v", text)
+	}
+	if text := m.LowCloudsText(codes.French); text != "Stratocumulus ne résultant pas de l'étalement de Cumulus" {
+		t.Errorf("Invalid low clouds: %v", text)
+	}
+	if text := m.MiddleCloudsText(codes.English); text != "" {
+		t.Errorf("Invalid middle clouds for unavailable value: %v", text)
+	}
+	if text := m.CloudGenusText(2, codes.English); text != "Stratocumulus (Sc)" {
+		t.Errorf("Invalid cloud genus: %v", text)
+	}
+	f, _ := MeasureField("w1")
+	if text := f.Text(m, codes.English); text != "Rain" {
+		t.Errorf("Invalid field text: %v", text)
+	}
+}
@@ -0,0 +1,115 @@
+package synopcsv
+
+import "math"
+
+// Physical constants for dry air and water vapour
+const (
+	dryAirGasConstant  = 287.05 // Rd, in J/(kg.K)
+	dryAirHeatCapacity = 1004.6 // cp, in J/(kg.K)
+	molarMassRatio     = 0.622  // ε, water vapour over dry air
+	zeroCelsius        = 273.15 // in K
+)
+
+// saturationVapourPressure returns the saturation vapour pressure in Pa over water at t in K, from Bolton (1980)
+func saturationVapourPressure(t float64) float64 {
+	c := t - zeroCelsius
+	return 611.2 * math.Exp(17.67*c/(c+243.5))
+}
+
+// mixingRatio returns the mixing ratio in kg/kg for a vapour pressure e at pressure p, both in Pa
+func mixingRatio(e, p float64) float64 {
+	return molarMassRatio * e / (p - e)
+}
+
+// SaturationVapourPressure returns the saturation vapour pressure in Pa at Temperature
+func (m Measure) SaturationVapourPressure() (float64, bool) {
+	if m.Temperature == nil {
+		return 0, false
+	}
+	return saturationVapourPressure(*m.Temperature), true
+}
+
+// VapourPressure returns the vapour pressure in Pa, as the saturation vapour pressure at DewPoint
+func (m Measure) VapourPressure() (float64, bool) {
+	if m.DewPoint == nil {
+		return 0, false
+	}
+	return saturationVapourPressure(*m.DewPoint), true
+}
+
+// RelativeHumidity returns the relative humidity in % computed from Temperature and DewPoint, to be checked against Humidity
+func (m Measure) RelativeHumidity() (float64, bool) {
+	e, ok := m.VapourPressure()
+	es, ok2 := m.SaturationVapourPressure()
+	if !ok || !ok2 {
+		return 0, false
+	}
+	return 100 * e / es, true
+}
+
+// moistAir returns the vapour pressure and the station pressure, both in Pa
+func (m Measure) moistAir() (e float64, p float64, ok bool) {
+	e, ok = m.VapourPressure()
+	if !ok || m.PressureStation == nil {
+		return 0, 0, false
+	}
+	return e, float64(*m.PressureStation), true
+}
+
+// MixingRatio returns the water vapour mixing ratio in kg/kg at station pressure
+func (m Measure) MixingRatio() (float64, bool) {
+	e, p, ok := m.moistAir()
+	if !ok {
+		return 0, false
+	}
+	return mixingRatio(e, p), true
+}
+
+// SpecificHumidity returns the specific humidity in kg/kg at station pressure
+func (m Measure) SpecificHumidity() (float64, bool) {
+	e, p, ok := m.moistAir()
+	if !ok {
+		return 0, false
+	}
+	return molarMassRatio * e / (p - (1-molarMassRatio)*e), true
+}
+
+// VirtualTemperature returns the virtual temperature in K, the temperature dry air would need to have the same density
+func (m Measure) VirtualTemperature() (float64, bool) {
+	r, ok := m.MixingRatio()
+	if !ok || m.Temperature == nil {
+		return 0, false
+	}
+	return *m.Temperature * (1 + r/molarMassRatio) / (1 + r), true
+}
+
+// AirDensity returns the density of moist air in kg/m3 at station pressure
+func (m Measure) AirDensity() (float64, bool) {
+	tv, ok := m.VirtualTemperature()
+	if !ok {
+		return 0, false
+	}
+	return float64(*m.PressureStation) / (dryAirGasConstant * tv), true
+}
+
+// PotentialTemperature returns the potential temperature in K, the temperature air at station pressure would have at 1000 hPa
+func (m Measure) PotentialTemperature() (float64, bool) {
+	if m.Temperature == nil || m.PressureStation == nil {
+		return 0, false
+	}
+	return *m.Temperature * math.Pow(100000/float64(*m.PressureStation), dryAirGasConstant/dryAirHeatCapacity), true
+}
+
+// EquivalentPotentialTemperature returns the equivalent potential temperature in K, from Bolton (1980)
+func (m Measure) EquivalentPotentialTemperature() (float64, bool) {
+	r, ok := m.MixingRatio()
+	if !ok || m.Temperature == nil {
+		return 0, false
+	}
+	t, td, p := *m.Temperature, *m.DewPoint, float64(*m.PressureStation)
+	r *= 1000 // in g/kg
+	// temperature at the lifted condensation level
+	tl := 1/(1/(td-56)+math.Log(t/td)/800) + 56
+	theta := t * math.Pow(100000/p, 0.2854*(1-0.00028*r))
+	return theta * math.Exp((3.376/tl-0.00254)*r*(1+0.00081*r)), true
+}
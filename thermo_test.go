@@ -0,0 +1,43 @@
+package synopcsv
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestThermodynamics(t *testing.T) {
+	measures, err := ParseMeasureCSV(strings.NewReader(testMeasureCSV))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// 07005: t=283.45 K, td=281.65 K, u=88%, pres=101040 Pa
+	m := measures[0]
+	tests := []struct {
+		name      string
+		f         func() (float64, bool)
+		expected  float64
+		tolerance float64
+	}{
+		{"saturation vapour pressure", m.SaturationVapourPressure, 1255, 5},
+		{"vapour pressure", m.VapourPressure, 1107, 5},
+		{"relative humidity", m.RelativeHumidity, float64(*m.Humidity), 1},
+		{"mixing ratio", m.MixingRatio, 0.00689, 0.00005},
+		{"specific humidity", m.SpecificHumidity, 0.00684, 0.00005},
+		{"virtual temperature", m.VirtualTemperature, 284.63, 0.05},
+		{"air density", m.AirDensity, 1.2366, 0.001},
+		{"potential temperature", m.PotentialTemperature, 282.61, 0.05},
+		{"equivalent potential temperature", m.EquivalentPotentialTemperature, 302.0, 0.5},
+	}
+	for _, test := range tests {
+		v, ok := test.f()
+		if !ok || math.Abs(v-test.expected) > test.tolerance {
+			t.Errorf("Invalid %v: %v, expected %v", test.name, v, test.expected)
+		}
+	}
+
+	m.PressureStation = nil
+	if _, ok := m.MixingRatio(); ok {
+		t.Error("mixing ratio needs station pressure")
+	}
+}
@@ -0,0 +1,194 @@
+// Package units converts SYNOP values from the units used by Météo-France files:
+// temperatures in K, pressures in Pa, speeds in m/s and lengths in m
+package units
+
+// Temperature is a temperature unit
+type Temperature int
+
+// Temperature units
+const (
+	Kelvin Temperature = iota
+	Celsius
+	Fahrenheit
+)
+
+// FromKelvin converts k from K to u
+func (u Temperature) FromKelvin(k float64) float64 {
+	switch u {
+	case Celsius:
+		return k - 273.15
+	case Fahrenheit:
+		return (k-273.15)*9/5 + 32
+	default:
+		return k
+	}
+}
+
+// ToKelvin converts v from u to K
+func (u Temperature) ToKelvin(v float64) float64 {
+	switch u {
+	case Celsius:
+		return v + 273.15
+	case Fahrenheit:
+		return (v-32)*5/9 + 273.15
+	default:
+		return v
+	}
+}
+
+func (u Temperature) String() string {
+	switch u {
+	case Celsius:
+		return "°C"
+	case Fahrenheit:
+		return "°F"
+	default:
+		return "K"
+	}
+}
+
+// Pressure is a pressure unit
+type Pressure int
+
+// Pressure units
+const (
+	Pa Pressure = iota
+	HPa
+	KPa
+	InHg
+	MmHg
+)
+
+// pascals holds the value of each pressure unit in Pa
+var pascals = map[Pressure]float64{
+	Pa:   1,
+	HPa:  100,
+	KPa:  1000,
+	InHg: 3386.389,
+	MmHg: 133.322387415,
+}
+
+// FromPa converts pa from Pa to u
+func (u Pressure) FromPa(pa float64) float64 {
+	return pa / pascals[u]
+}
+
+// ToPa converts v from u to Pa
+func (u Pressure) ToPa(v float64) float64 {
+	return v * pascals[u]
+}
+
+func (u Pressure) String() string {
+	switch u {
+	case HPa:
+		return "hPa"
+	case KPa:
+		return "kPa"
+	case InHg:
+		return "inHg"
+	case MmHg:
+		return "mmHg"
+	default:
+		return "Pa"
+	}
+}
+
+// Speed is a speed unit
+type Speed int
+
+// Speed units
+const (
+	MetersPerSecond Speed = iota
+	KilometersPerHour
+	Knots
+	MilesPerHour
+)
+
+// metersPerSecond holds the value of each speed unit in m/s
+var metersPerSecond = map[Speed]float64{
+	MetersPerSecond:   1,
+	KilometersPerHour: 1 / 3.6,
+	Knots:             1852.0 / 3600,
+	MilesPerHour:      0.44704,
+}
+
+// FromMetersPerSecond converts v from m/s to u
+func (u Speed) FromMetersPerSecond(v float64) float64 {
+	return v / metersPerSecond[u]
+}
+
+// ToMetersPerSecond converts v from u to m/s
+func (u Speed) ToMetersPerSecond(v float64) float64 {
+	return v * metersPerSecond[u]
+}
+
+func (u Speed) String() string {
+	switch u {
+	case KilometersPerHour:
+		return "km/h"
+	case Knots:
+		return "kt"
+	case MilesPerHour:
+		return "mph"
+	default:
+		return "m/s"
+	}
+}
+
+// Length is a length unit
+type Length int
+
+// Length units
+const (
+	Meters Length = iota
+	Millimeters
+	Centimeters
+	Kilometers
+	Inches
+	Feet
+	Miles
+	NauticalMiles
+)
+
+// meters holds the value of each length unit in m
+var meters = map[Length]float64{
+	Meters:        1,
+	Millimeters:   0.001,
+	Centimeters:   0.01,
+	Kilometers:    1000,
+	Inches:        0.0254,
+	Feet:          0.3048,
+	Miles:         1609.344,
+	NauticalMiles: 1852,
+}
+
+// FromMeters converts v from m to u
+func (u Length) FromMeters(v float64) float64 {
+	return v / meters[u]
+}
+
+// ToMeters converts v from u to m
+func (u Length) ToMeters(v float64) float64 {
+	return v * meters[u]
+}
+
+func (u Length) String() string {
+	switch u {
+	case Millimeters:
+		return "mm"
+	case Centimeters:
+		return "cm"
+	case Kilometers:
+		return "km"
+	case Inches:
+		return "in"
+	case Feet:
+		return "ft"
+	case Miles:
+		return "mi"
+	case NauticalMiles:
+		return "NM"
+	default:
+		return "m"
+	}
+}
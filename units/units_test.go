@@ -0,0 +1,33 @@
+package units
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestConversions(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"K to °C", Celsius.FromKelvin(283.45), 10.3},
+		{"K to °F", Fahrenheit.FromKelvin(273.15), 32},
+		{"°F to K", Fahrenheit.ToKelvin(212), 373.15},
+		{"Pa to hPa", HPa.FromPa(101650), 1016.5},
+		{"inHg to Pa", InHg.ToPa(1), 3386.389},
+		{"m/s to kt", Knots.FromMetersPerSecond(1852.0 / 3600), 1},
+		{"m/s to km/h", KilometersPerHour.FromMetersPerSecond(10), 36},
+		{"m to ft", Feet.FromMeters(0.3048), 1},
+		{"mm to m", Millimeters.ToMeters(12), 0.012},
+	}
+	for _, test := range tests {
+		if !almostEqual(test.got, test.expected) {
+			t.Errorf("Invalid conversion %v: %v, expected %v", test.name, test.got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package synopcsv
+
+import (
+	"math"
+
+	"github.com/jfyuen/synopcsv/codes"
+)
+
+// variableWindDirection is the direction reported when the wind direction is variable
+const variableWindDirection = 990
+
+// beaufortScale holds the upper bound in m/s and description of each Beaufort force
+var beaufortScale = []struct {
+	max float64
+	codes.Entry
+}{
+	{0.5, codes.Entry{Code: 0, English: "Calm", French: "Calme"}},
+	{1.6, codes.Entry{Code: 1, English: "Light air", French: "Très légère brise"}},
+	{3.4, codes.Entry{Code: 2, English: "Light breeze", French: "Légère brise"}},
+	{5.5, codes.Entry{Code: 3, English: "Gentle breeze", French: "Petite brise"}},
+	{8.0, codes.Entry{Code: 4, English: "Moderate breeze", French: "Jolie brise"}},
+	{10.8, codes.Entry{Code: 5, English: "Fresh breeze", French: "Bonne brise"}},
+	{13.9, codes.Entry{Code: 6, English: "Strong breeze", French: "Vent frais"}},
+	{17.2, codes.Entry{Code: 7, English: "Near gale", French: "Grand frais"}},
+	{20.8, codes.Entry{Code: 8, English: "Gale", French: "Coup de vent"}},
+	{24.5, codes.Entry{Code: 9, English: "Strong gale", French: "Fort coup de vent"}},
+	{28.5, codes.Entry{Code: 10, English: "Storm", French: "Tempête"}},
+	{32.7, codes.Entry{Code: 11, English: "Violent storm", French: "Violente tempête"}},
+	{math.Inf(1), codes.Entry{Code: 12, English: "Hurricane force", French: "Ouragan"}},
+}
+
+// compassPoints are the 16 points of the compass, clockwise from north
+var compassPoints = []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+
+// Beaufort returns the Beaufort force of a wind speed in m/s
+func Beaufort(speed float64) int {
+	for _, b := range beaufortScale {
+		if speed < b.max {
+			return b.Code
+		}
+	}
+	return 12
+}
+
+// BeaufortText describes a Beaufort force in lang, or returns an empty string if force is not between 0 and 12
+func BeaufortText(force int, lang codes.Language) string {
+	if force < 0 || force >= len(beaufortScale) {
+		return ""
+	}
+	return beaufortScale[force].Text(lang)
+}
+
+// Compass returns the 16-point compass name of a direction in degrees
+func Compass(direction float64) string {
+	d := math.Mod(direction, 360)
+	if d < 0 {
+		d += 360
+	}
+	return compassPoints[int(math.Floor(d/22.5+0.5))%len(compassPoints)]
+}
+
+// WindComponents returns the u (eastward) and v (northward) components of a wind blowing from direction in degrees at speed
+func WindComponents(direction, speed float64) (u, v float64) {
+	r := direction * math.Pi / 180
+	return -speed * math.Sin(r), -speed * math.Cos(r)
+}
+
+// WindFromComponents returns the direction in degrees the wind blows from and its speed, from its u and v components.
+// Direction is 0 for a null wind.
+func WindFromComponents(u, v float64) (direction, speed float64) {
+	speed = math.Hypot(u, v)
+	if speed == 0 {
+		return 0, 0
+	}
+	direction = math.Mod(math.Atan2(-u, -v)*180/math.Pi+360, 360)
+	return direction, speed
+}
+
+// IsCalm returns true if the wind is reported calm, with a null direction or a speed under 0.5 m/s
+func (m Measure) IsCalm() bool {
+	return (m.WindDirection != nil && *m.WindDirection == 0) || (m.WindSpeed != nil && *m.WindSpeed < beaufortScale[0].max)
+}
+
+// IsVariable returns true if the wind direction is reported variable
+func (m Measure) IsVariable() bool {
+	return m.WindDirection != nil && (*m.WindDirection == variableWindDirection || *m.WindDirection > 360)
+}
+
+// WindComponents returns the u and v components of the wind in m/s, null for a calm wind.
+// They are not available for a variable wind.
+func (m Measure) WindComponents() (u, v float64, ok bool) {
+	if m.IsCalm() {
+		return 0, 0, true
+	}
+	if m.WindDirection == nil || m.WindSpeed == nil || m.IsVariable() {
+		return 0, 0, false
+	}
+	u, v = WindComponents(float64(*m.WindDirection), *m.WindSpeed)
+	return u, v, true
+}
+
+// Beaufort returns the Beaufort force of WindSpeed
+func (m Measure) Beaufort() (int, bool) {
+	if m.WindSpeed == nil {
+		return 0, false
+	}
+	return Beaufort(*m.WindSpeed), true
+}
+
+// WindCompass returns the 16-point compass name of WindDirection, not available for a calm or variable wind
+func (m Measure) WindCompass() (string, bool) {
+	if m.WindDirection == nil || m.IsCalm() || m.IsVariable() {
+		return "", false
+	}
+	return Compass(float64(*m.WindDirection)), true
+}
+
+// GustFactor returns the ratio of Last10MinutesGust to WindSpeed, not available for a calm wind
+func (m Measure) GustFactor() (float64, bool) {
+	if m.Last10MinutesGust == nil || m.WindSpeed == nil || m.IsCalm() {
+		return 0, false
+	}
+	return *m.Last10MinutesGust / *m.WindSpeed, true
+}
+
+// AverageWind returns the vector average of the wind of measures, as the direction in degrees it blows from and its speed in m/s.
+// Measures without wind components are ignored, calm winds are counted as null vectors.
+func AverageWind(measures []Measure) (direction, speed float64, ok bool) {
+	var su, sv float64
+	n := 0
+	for _, m := range measures {
+		u, v, ok := m.WindComponents()
+		if !ok {
+			continue
+		}
+		su += u
+		sv += v
+		n++
+	}
+	if n == 0 {
+		return 0, 0, false
+	}
+	direction, speed = WindFromComponents(su/float64(n), sv/float64(n))
+	return direction, speed, true
+}
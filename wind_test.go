@@ -0,0 +1,101 @@
+package synopcsv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jfyuen/synopcsv/codes"
+)
+
+func windMeasure(direction int, speed float64) Measure {
+	return Measure{WindDirection: &direction, WindSpeed: &speed}
+}
+
+func TestWindComponents(t *testing.T) {
+	tests := []struct {
+		direction int
+		speed     float64
+		u, v      float64
+		ok        bool
+	}{
+		{360, 10, 0, -10, true},
+		{90, 5, -5, 0, true},
+		{225, 2, math.Sqrt2, math.Sqrt2, true},
+		{0, 0, 0, 0, true},
+		{990, 3, 0, 0, false},
+	}
+	for _, test := range tests {
+		m := windMeasure(test.direction, test.speed)
+		u, v, ok := m.WindComponents()
+		if ok != test.ok || math.Abs(u-test.u) > 1e-9 || math.Abs(v-test.v) > 1e-9 {
+			t.Errorf("Invalid components for %v° %v m/s: (%v, %v, %v), expected (%v, %v, %v)", test.direction, test.speed, u, v, ok, test.u, test.v, test.ok)
+		}
+		if !ok {
+			continue
+		}
+		if d, s := WindFromComponents(u, v); test.speed != 0 && (math.Abs(s-test.speed) > 1e-9 || Compass(d) != Compass(float64(test.direction))) {
+			t.Errorf("Invalid wind from components for %v° %v m/s: %v° %v m/s", test.direction, test.speed, d, s)
+		}
+	}
+}
+
+func TestWindDetection(t *testing.T) {
+	if m := windMeasure(0, 0); !m.IsCalm() || m.IsVariable() {
+		t.Errorf("Null wind should be calm")
+	}
+	if m := windMeasure(990, 2); m.IsCalm() || !m.IsVariable() {
+		t.Errorf("Wind from 990 should be variable")
+	}
+	if _, ok := windMeasure(990, 2).WindCompass(); ok {
+		t.Errorf("Variable wind should not have a compass direction")
+	}
+	if c, ok := windMeasure(200, 4).WindCompass(); !ok || c != "SSW" {
+		t.Errorf("Invalid compass direction %q, expected SSW", c)
+	}
+}
+
+func TestAverageWind(t *testing.T) {
+	measures := []Measure{windMeasure(350, 4), windMeasure(10, 4), windMeasure(990, 2), {}}
+	d, s, ok := AverageWind(measures)
+	if !ok || math.Abs(d) > 1e-9 && math.Abs(d-360) > 1e-9 || math.Abs(s-4*math.Cos(10*math.Pi/180)) > 1e-9 {
+		t.Errorf("Invalid average wind %v° %v m/s", d, s)
+	}
+	if _, _, ok := AverageWind([]Measure{{}}); ok {
+		t.Errorf("Average wind should not be available without wind")
+	}
+}
+
+func TestBeaufort(t *testing.T) {
+	tests := []struct {
+		speed float64
+		force int
+	}{{0, 0}, {0.5, 1}, {5.5, 4}, {20, 8}, {40, 12}}
+	for _, test := range tests {
+		if f := Beaufort(test.speed); f != test.force {
+			t.Errorf("Invalid Beaufort force for %v m/s: %v, expected %v", test.speed, f, test.force)
+		}
+	}
+	if s := BeaufortText(8, codes.French); s != "Coup de vent" {
+		t.Errorf("Invalid Beaufort description %q", s)
+	}
+	if s := BeaufortText(13, codes.English); s != "" {
+		t.Errorf("Beaufort force 13 should not have a description, got %q", s)
+	}
+}
+
+func TestGustFactor(t *testing.T) {
+	m := windMeasure(180, 5)
+	gust := 8.0
+	m.Last10MinutesGust = &gust
+	if g, ok := m.GustFactor(); !ok || g != 1.6 {
+		t.Errorf("Invalid gust factor %v", g)
+	}
+	if _, ok := windMeasure(0, 0).GustFactor(); ok {
+		t.Errorf("Gust factor should not be available for a calm wind")
+	}
+	m = windMeasure(180, 0.2)
+	m.Last10MinutesGust = &gust
+	if _, ok := m.GustFactor(); ok {
+		t.Errorf("Gust factor should not be available below 0.3 m/s")
+	}
+}
@@ -0,0 +1,119 @@
+package windrose
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+
+	"github.com/jfyuen/synopcsv"
+	"github.com/pkg/errors"
+)
+
+// palette colors speed classes, from the lowest to the highest
+var palette = []string{"#ffffcc", "#c7e9b4", "#7fcdbb", "#41b6c4", "#1d91c0", "#225ea8", "#253494", "#081d58"}
+
+// classLabel describes speed class i
+func (r *Rose) classLabel(i int) string {
+	switch {
+	case len(r.Bins) == 0:
+		return "all speeds"
+	case i == 0:
+		return fmt.Sprintf("< %v m/s", r.Bins[0])
+	case i == len(r.Bins):
+		return fmt.Sprintf("≥ %v m/s", r.Bins[i-1])
+	default:
+		return fmt.Sprintf("%v - %v m/s", r.Bins[i-1], r.Bins[i])
+	}
+}
+
+// point returns the svg coordinates at radius and direction in degrees, north up, from center c
+func point(c, radius, direction float64) (float64, float64) {
+	a := direction * math.Pi / 180
+	return c + radius*math.Sin(a), c - radius*math.Cos(a)
+}
+
+// WriteSVG renders r as a standalone SVG document of size pixels, with a legend on the right.
+// Petals stack the speed classes of each sector, their length being proportional to the sector frequency.
+func (r *Rose) WriteSVG(out io.Writer, title string, size int) error {
+	w := bufio.NewWriter(out)
+	c := float64(size) / 2
+	outer := c * 0.8
+	inner := outer * 0.1 // calms are written in the inner circle
+
+	top := 0.0
+	for s := 0; s < r.Sectors; s++ {
+		top = math.Max(top, r.SectorFrequency(s))
+	}
+	step := 5.0
+	for top > 4*step {
+		step *= 2
+	}
+	top = math.Max(step, math.Ceil(top/step)*step)
+	scale := func(f float64) float64 { return inner + (outer-inner)*f/top }
+
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>`+"\n")
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="%.0f">`+"\n", size+size/3, size, size+size/3, size, c/20)
+	fmt.Fprintf(w, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+	if title != "" {
+		fmt.Fprintf(w, `<text x="%.2f" y="%.2f" text-anchor="middle" font-weight="bold">%s</text>`+"\n", c, c/10, html.EscapeString(title))
+	}
+
+	fmt.Fprintf(w, `<g fill="none" stroke="#bbb">`+"\n")
+	for f := step; f <= top; f += step {
+		fmt.Fprintf(w, `<circle cx="%.2f" cy="%.2f" r="%.2f"/>`+"\n", c, c, scale(f))
+	}
+	for d := 0.0; d < 360; d += 45 {
+		x1, y1 := point(c, inner, d)
+		x2, y2 := point(c, outer, d)
+		fmt.Fprintf(w, `<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f"/>`+"\n", x1, y1, x2, y2)
+	}
+	fmt.Fprintf(w, "</g>\n")
+
+	half := 180 / float64(r.Sectors) * 0.9
+	// petals of less than 2 sectors span more than half a circle
+	largeArc := 0
+	if 2*half > 180 {
+		largeArc = 1
+	}
+	for s := 0; s < r.Sectors; s++ {
+		d := r.Direction(s)
+		from := 0.0
+		for class := 0; class < r.Classes(); class++ {
+			f := r.Frequency(s, class)
+			if f == 0 {
+				continue
+			}
+			r0, r1 := scale(from), scale(from+f)
+			from += f
+			x1, y1 := point(c, r0, d-half)
+			x2, y2 := point(c, r1, d-half)
+			x3, y3 := point(c, r1, d+half)
+			x4, y4 := point(c, r0, d+half)
+			fmt.Fprintf(w, `<path d="M%.2f %.2f L%.2f %.2f A%.2f %.2f 0 %d 1 %.2f %.2f L%.2f %.2f A%.2f %.2f 0 %d 0 %.2f %.2fZ" fill="%s" stroke="#555" stroke-width="0.5"/>`+"\n",
+				x1, y1, x2, y2, r1, r1, largeArc, x3, y3, x4, y4, r0, r0, largeArc, x1, y1, palette[class%len(palette)])
+		}
+	}
+
+	fmt.Fprintf(w, `<circle cx="%.2f" cy="%.2f" r="%.2f" fill="white" stroke="#555"/>`+"\n", c, c, inner)
+	fmt.Fprintf(w, `<text x="%.2f" y="%.2f" text-anchor="middle" dominant-baseline="middle" font-size="%.0f">%.1f%%</text>`+"\n", c, c, c/30, r.CalmPercentage())
+	for f := step; f <= top; f += step {
+		x, y := point(c, scale(f), 22.5)
+		fmt.Fprintf(w, `<text x="%.2f" y="%.2f" fill="#777" font-size="%.0f">%v%%</text>`+"\n", x, y, c/30, f)
+	}
+	for d := 0.0; d < 360; d += 90 {
+		x, y := point(c, outer+c/12, d)
+		fmt.Fprintf(w, `<text x="%.2f" y="%.2f" text-anchor="middle" dominant-baseline="middle">%s</text>`+"\n", x, y, synopcsv.Compass(d))
+	}
+
+	lx, ly, box := float64(size)+c/20, c/2, c/15
+	fmt.Fprintf(w, `<text x="%.2f" y="%.2f">Calm: %.1f%% (%d winds)</text>`+"\n", lx, ly-box, r.CalmPercentage(), r.Total)
+	for class := 0; class < r.Classes(); class++ {
+		y := ly + float64(class)*box*1.5
+		fmt.Fprintf(w, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s" stroke="#555" stroke-width="0.5"/>`+"\n", lx, y, box, box, palette[class%len(palette)])
+		fmt.Fprintf(w, `<text x="%.2f" y="%.2f" dominant-baseline="middle">%s</text>`+"\n", lx+box*1.5, y+box/2, html.EscapeString(r.classLabel(class)))
+	}
+	fmt.Fprintf(w, "</svg>\n")
+	return errors.WithStack(w.Flush())
+}
@@ -0,0 +1,137 @@
+// Package windrose builds wind roses from SYNOP measures: the frequency of winds by direction sector and speed class.
+package windrose
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jfyuen/synopcsv"
+	"github.com/pkg/errors"
+)
+
+// DefaultSectors is the number of direction sectors of a wind rose built by New
+const DefaultSectors = 16
+
+// DefaultBins are the speed classes bounds in m/s of a wind rose built by New
+var DefaultBins = []float64{2, 4, 6, 8, 10}
+
+// Rose is a direction × speed class frequency table.
+// Sector i is centered on i×360/Sectors degrees, speed class j holds speeds between Bins[j-1] and Bins[j],
+// the first class starting from 0 and the last one being unbounded.
+type Rose struct {
+	Sectors int
+	Bins    []float64
+	Counts  [][]int // number of winds by sector and speed class
+	Calms   int     // number of calm winds
+	Total   int     // number of winds counted, including calms
+}
+
+// New returns an empty wind rose with DefaultSectors and DefaultBins
+func New() *Rose {
+	r, _ := NewRose(DefaultSectors, DefaultBins)
+	return r
+}
+
+// NewRose returns an empty wind rose with sectors direction sectors and speed classes bounded by bins, in m/s
+func NewRose(sectors int, bins []float64) (*Rose, error) {
+	if sectors < 1 || sectors > 360 {
+		return nil, errors.Errorf("invalid number of sectors %v, must be between 1 and 360", sectors)
+	}
+	if !sort.Float64sAreSorted(bins) {
+		return nil, errors.Errorf("speed bins %v must be sorted", bins)
+	}
+	for i, b := range bins {
+		if b <= 0 || (i > 0 && b == bins[i-1]) {
+			return nil, errors.Errorf("speed bins %v must be positive and distinct", bins)
+		}
+	}
+	r := &Rose{Sectors: sectors, Bins: append([]float64(nil), bins...), Counts: make([][]int, sectors)}
+	for i := range r.Counts {
+		r.Counts[i] = make([]int, len(bins)+1)
+	}
+	return r, nil
+}
+
+// Build returns the wind rose of measures
+func Build(measures []synopcsv.Measure, sectors int, bins []float64) (*Rose, error) {
+	r, err := NewRose(sectors, bins)
+	if err != nil {
+		return nil, err
+	}
+	r.AddAll(measures)
+	return r, nil
+}
+
+// Classes returns the number of speed classes
+func (r *Rose) Classes() int {
+	return len(r.Bins) + 1
+}
+
+// Sector returns the sector of a direction in degrees
+func (r *Rose) Sector(direction float64) int {
+	width := 360 / float64(r.Sectors)
+	d := math.Mod(direction+width/2, 360)
+	if d < 0 {
+		d += 360
+	}
+	return int(d/width) % r.Sectors
+}
+
+// Direction returns the direction in degrees sector is centered on
+func (r *Rose) Direction(sector int) float64 {
+	return float64(sector) * 360 / float64(r.Sectors)
+}
+
+// Class returns the speed class of speed in m/s
+func (r *Rose) Class(speed float64) int {
+	return sort.Search(len(r.Bins), func(i int) bool { return speed < r.Bins[i] })
+}
+
+// Add counts the wind of m, returning false if m has no usable wind: missing or variable
+func (r *Rose) Add(m synopcsv.Measure) bool {
+	if m.IsCalm() {
+		r.Calms++
+		r.Total++
+		return true
+	}
+	if m.WindDirection == nil || m.WindSpeed == nil || m.IsVariable() {
+		return false
+	}
+	r.Counts[r.Sector(float64(*m.WindDirection))][r.Class(*m.WindSpeed)]++
+	r.Total++
+	return true
+}
+
+// AddAll counts the winds of measures
+func (r *Rose) AddAll(measures []synopcsv.Measure) {
+	for _, m := range measures {
+		r.Add(m)
+	}
+}
+
+// percentage returns n in percent of all counted winds
+func (r *Rose) percentage(n int) float64 {
+	if r.Total == 0 {
+		return 0
+	}
+	return 100 * float64(n) / float64(r.Total)
+}
+
+// Frequency returns the percentage of winds in sector and speed class
+func (r *Rose) Frequency(sector, class int) float64 {
+	return r.percentage(r.Counts[sector][class])
+}
+
+// SectorFrequency returns the percentage of winds in sector, all speed classes included
+func (r *Rose) SectorFrequency(sector int) float64 {
+	n := 0
+	for _, c := range r.Counts[sector] {
+		n += c
+	}
+	return r.percentage(n)
+}
+
+// CalmPercentage returns the percentage of calm winds
+func (r *Rose) CalmPercentage() float64 {
+	return r.percentage(r.Calms)
+}
@@ -0,0 +1,104 @@
+package windrose
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/jfyuen/synopcsv"
+)
+
+func wind(direction int, speed float64) synopcsv.Measure {
+	return synopcsv.Measure{WindDirection: &direction, WindSpeed: &speed}
+}
+
+func TestBuild(t *testing.T) {
+	measures := []synopcsv.Measure{
+		wind(360, 3), wind(10, 1), wind(350, 12), wind(90, 5), wind(0, 0), wind(990, 2), {},
+	}
+	r, err := Build(measures, 4, []float64{2, 4})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if r.Total != 5 || r.Calms != 1 {
+		t.Fatalf("Invalid counts: %v winds, %v calms", r.Total, r.Calms)
+	}
+	expected := [][]int{{1, 1, 1}, {0, 0, 1}, {0, 0, 0}, {0, 0, 0}}
+	for s := range expected {
+		for c := range expected[s] {
+			if r.Counts[s][c] != expected[s][c] {
+				t.Errorf("Invalid count for sector %v class %v: %v, expected %v", s, c, r.Counts[s][c], expected[s][c])
+			}
+		}
+	}
+	if f := r.CalmPercentage(); f != 20 {
+		t.Errorf("Invalid calm percentage %v", f)
+	}
+	if f := r.SectorFrequency(0); math.Abs(f-60) > 1e-9 {
+		t.Errorf("Invalid north sector frequency %v", f)
+	}
+}
+
+func TestNewRoseInvalid(t *testing.T) {
+	if _, err := NewRose(0, DefaultBins); err == nil {
+		t.Errorf("A wind rose without sectors should fail")
+	}
+	if _, err := NewRose(8, []float64{4, 2}); err == nil {
+		t.Errorf("A wind rose with unsorted bins should fail")
+	}
+}
+
+func TestSector(t *testing.T) {
+	r := New()
+	tests := []struct {
+		direction float64
+		sector    int
+	}{{0, 0}, {11, 0}, {12, 1}, {348, 15}, {349, 0}, {360, 0}, {180, 8}}
+	for _, test := range tests {
+		if s := r.Sector(test.direction); s != test.sector {
+			t.Errorf("Invalid sector for %v°: %v, expected %v", test.direction, s, test.sector)
+		}
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	r, err := Build([]synopcsv.Measure{wind(200, 3), wind(220, 7), wind(0, 0)}, 8, []float64{2, 4, 6})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := r.WriteSVG(buf, "07005 <test>", 400); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	d := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+	for {
+		_, err := d.Token()
+		if err != nil {
+			if err != io.EOF {
+				t.Fatalf("Invalid SVG: %v", err)
+			}
+			break
+		}
+	}
+	if s := buf.String(); !strings.Contains(s, "07005 &lt;test&gt;") || strings.Count(s, "<path") != 2 {
+		t.Errorf("Unexpected SVG content:\n%v", s)
+	}
+}
+
+func TestWriteSVGSingleSector(t *testing.T) {
+	r, err := Build([]synopcsv.Measure{wind(90, 3)}, 1, []float64{2})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := r.WriteSVG(buf, "", 400); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// a single petal spans more than half a circle
+	if s := buf.String(); !strings.Contains(s, " 0 1 1 ") || !strings.Contains(s, " 0 1 0 ") {
+		t.Errorf("Expected large arcs in the petal:\n%v", s)
+	}
+}
@@ -0,0 +1,122 @@
+package synopcsv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MeasureWriter writes measures as a CSV file formated as https://donneespubliques.meteofrance.fr/client/document/doc_parametres_synop_168.pdf
+// Written measures are parsed back as the same measures by ParseMeasureCSV, except for Invalid fields written as not reported.
+type MeasureWriter struct {
+	w             *csv.Writer
+	record        []string
+	headerWritten bool
+}
+
+// NewMeasureWriter returns a MeasureWriter writing to out, to be flushed by the caller
+func NewMeasureWriter(out io.Writer) *MeasureWriter {
+	w := csv.NewWriter(out)
+	w.Comma = ';'
+	// Météo-France files end each line with a separator
+	return &MeasureWriter{w: w, record: make([]string, len(measureFields)+1)}
+}
+
+func (w *MeasureWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	for i, f := range measureFields {
+		w.record[i] = f.Column
+	}
+	return errors.WithStack(w.w.Write(w.record))
+}
+
+// Write writes a single measure, the header being written before the first one
+func (w *MeasureWriter) Write(m Measure) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	for i, f := range measureFields {
+		w.record[i] = formatField(f, m)
+	}
+	return errors.WithStack(w.w.Write(w.record))
+}
+
+// Flush writes buffered data to the underlying writer, with the header if no measure was written
+func (w *MeasureWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return errors.WithStack(w.w.Error())
+}
+
+// formatField formats the field value in m, writing the not observable code of the field code table if any
+func formatField(f Field, m Measure) string {
+	v := f.Value(m)
+	if v == nil && f.Status(m) == NotObservable {
+		if code, ok := notObservableCodes[f.Code]; ok {
+			return strconv.Itoa(code)
+		}
+	}
+	return formatValue(v)
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return na
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	case SpecialPhenomenon:
+		return v.String()
+	case time.Time:
+		return v.UTC().Format("20060102150405")
+	default:
+		panic(fmt.Sprintf("unsupported value type %T", v))
+	}
+}
+
+// WriteMeasureCSV writes measures as a CSV file readable by ParseMeasureCSV
+func WriteMeasureCSV(out io.Writer, measures []Measure) error {
+	w := NewMeasureWriter(out)
+	for _, m := range measures {
+		if err := w.Write(m); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// WriteStationsCSV writes stations as a CSV file formated as "https://donneespubliques.meteofrance.fr/donnees_libres/Txt/Synop/postesSynop.csv"
+func WriteStationsCSV(out io.Writer, stations []Station) error {
+	w := csv.NewWriter(out)
+	w.Comma = ';'
+	if err := w.Write([]string{"ID", "Nom", "Latitude", "Longitude", "Altitude"}); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, s := range stations {
+		record := []string{
+			s.ID,
+			s.Name,
+			strconv.FormatFloat(s.Latitude, 'f', -1, 64),
+			strconv.FormatFloat(s.Longitude, 'f', -1, 64),
+			strconv.FormatFloat(s.Altitude, 'f', -1, 64),
+		}
+		if err := w.Write(record); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	w.Flush()
+	return errors.WithStack(w.Error())
+}
@@ -0,0 +1,62 @@
+package synopcsv
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jfyuen/synopcsv/synopcsvtest"
+)
+
+func TestWriteMeasureCSVRoundTrip(t *testing.T) {
+	f, err := synopcsvtest.Fixtures().Open("Archive/synop.201705.csv.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	measures, err := ParseMeasureCSV(f)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := WriteMeasureCSV(buf, measures); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	header := strings.SplitN(testMeasureCSV, "\n", 2)[0]
+	if !strings.HasPrefix(buf.String(), header+"\n") {
+		t.Errorf("Invalid header: %v", strings.SplitN(buf.String(), "\n", 2)[0])
+	}
+	parsed, err := ParseMeasureCSV(buf)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !reflect.DeepEqual(measures, parsed) {
+		t.Error("measures differ after writing and parsing them back")
+	}
+}
+
+func TestWriteStationsCSVRoundTrip(t *testing.T) {
+	f, err := synopcsvtest.Fixtures().Open("postesSynop.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	stations, err := ParseStationsCSV(f)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := WriteStationsCSV(buf, stations); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	parsed, err := ParseStationsCSV(buf)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !reflect.DeepEqual(stations, parsed) {
+		t.Error("stations differ after writing and parsing them back")
+	}
+}